@@ -0,0 +1,230 @@
+package main
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"io"
+	"log/slog"
+	"os"
+
+	"github.com/fatih/color"
+	"github.com/wtnb75/uhd/pkg/uhd"
+)
+
+type byte_range struct {
+	start uint64
+	end   uint64
+}
+
+type differ struct {
+	output io.Writer
+	layout *uhd.Layout
+	inputs [2]io.ReaderAt
+	sizes  [2]uint64
+	ranges []byte_range
+}
+
+func (d *differ) row(idx int, row uint64) (uhd.Row, error) {
+	rows, err := d.layout.RenderRows(d.inputs[idx], d.sizes[idx], row, 1)
+	if err != nil || len(rows) == 0 {
+		return uhd.Row{}, err
+	}
+	return rows[0], nil
+}
+
+func (d *differ) read(idx int, row uint64) ([]byte, error) {
+	width := uint64(option.Width)
+	start := row * width
+	if start >= d.sizes[idx] {
+		return nil, nil
+	}
+	buf := make([]byte, min(width, d.sizes[idx]-start))
+	_, err := d.inputs[idx].ReadAt(buf, int64(start))
+	if err == io.EOF {
+		err = nil
+	}
+	return buf, err
+}
+
+// compare returns the indexes of the bytes that differ in a row. A byte that
+// exists in only one of the inputs differs.
+func compare(a, b []byte) []int {
+	res := []int{}
+	for i := range max(len(a), len(b)) {
+		if i >= len(a) || i >= len(b) || a[i] != b[i] {
+			res = append(res, i)
+		}
+	}
+	return res
+}
+
+func (d *differ) addRange(offset uint64) {
+	if n := len(d.ranges); n != 0 && d.ranges[n-1].end == offset {
+		d.ranges[n-1].end = offset + 1
+		return
+	}
+	d.ranges = append(d.ranges, byte_range{offset, offset + 1})
+}
+
+func (d *differ) print(prefix string, row uhd.Row, idxs ...int) {
+	if color.NoColor {
+		idxs = nil
+	}
+	fmt.Fprintln(d.output, prefix+" "+d.layout.ComposeRow(row, idxs...))
+}
+
+// squeeze counts the rows hidden behind "*" and the byte they are all
+// filled with, or -1.
+type squeeze struct {
+	rows int
+	size uint64
+	fill int
+}
+
+func (s *squeeze) add(data []byte) {
+	fill := -1
+	if len(data) != 0 && bytes.Count(data, data[:1]) == len(data) {
+		fill = int(data[0])
+	}
+	if s.rows == 0 {
+		s.fill = fill
+	} else if s.fill != fill {
+		s.fill = -1
+	}
+	s.rows++
+	s.size += uint64(len(data))
+}
+
+// Process dumps both inputs row by row. Differing rows are shown as a pair of
+// "-" and "+" lines and runs of identical rows are squeezed with "*" unless
+// --no-squeeze is given.
+func (d *differ) Process() error {
+	width := uint64(option.Width)
+	rows := (max(d.sizes[0], d.sizes[1]) + width - 1) / width
+	var last uhd.Row
+	var last_data []byte
+	var hidden squeeze
+	squeezed := -1
+	// flush shows the end of a run of identical rows
+	flush := func() {
+		if squeezed > 1 {
+			if option.SqueezeCount {
+				fmt.Fprintln(d.output, d.layout.SqueezeLine(hidden.rows, hidden.size, hidden.fill))
+			} else {
+				fmt.Fprintln(d.output, "*")
+			}
+		}
+		if squeezed > 0 {
+			d.print(" ", last)
+		}
+		squeezed = -1
+		hidden = squeeze{}
+	}
+	for row := range rows {
+		a, err := d.read(0, row)
+		if err != nil {
+			return err
+		}
+		b, err := d.read(1, row)
+		if err != nil {
+			return err
+		}
+		idxs := compare(a, b)
+		if len(idxs) == 0 {
+			if squeezed > 0 {
+				// the previous row is neither the first nor the last of the run
+				hidden.add(last_data)
+			}
+			if last, err = d.row(0, row); err != nil {
+				return err
+			}
+			last_data = a
+			if squeezed == -1 || option.NoSqueeze {
+				d.print(" ", last)
+			}
+			if option.NoSqueeze {
+				continue
+			}
+			squeezed++
+			continue
+		}
+		flush()
+		for _, idx := range idxs {
+			d.addRange(row*width + uint64(idx))
+		}
+		for idx, prefix := range []string{color.RedString("-"), color.GreenString("+")} {
+			r, err := d.row(idx, row)
+			if err != nil {
+				return err
+			}
+			d.print(prefix, r, idxs...)
+		}
+	}
+	flush()
+	return nil
+}
+
+func (d *differ) Summary() {
+	if len(d.ranges) == 0 {
+		fmt.Fprintln(d.output, "identical")
+		return
+	}
+	total := uint64(0)
+	for _, r := range d.ranges {
+		fmt.Fprintf(d.output, "%s-%s %d bytes\n", d.layout.FormatAddress(r.start), d.layout.FormatAddress(r.end-1), r.end-r.start)
+		total += r.end - r.start
+	}
+	if d.sizes[0] != d.sizes[1] {
+		fmt.Fprintf(d.output, "size: %d, %d\n", d.sizes[0], d.sizes[1])
+	}
+	fmt.Fprintf(d.output, "%d ranges, %d bytes differ\n", len(d.ranges), total)
+}
+
+func newDiffer(output io.Writer, layout *uhd.Layout, a, b io.ReaderAt, size_a, size_b uint64) *differ {
+	return &differ{
+		output: output,
+		layout: layout,
+		inputs: [2]io.ReaderAt{a, b},
+		sizes:  [2]uint64{size_a, size_b},
+	}
+}
+
+func do_diff(filenames []string) error {
+	if len(filenames) != 2 {
+		return errors.New("diff needs two files")
+	}
+	var inputs [2]*os.File
+	var sizes [2]uint64
+	for idx, fn := range filenames {
+		rd, err := os.Open(fn)
+		if err != nil {
+			slog.Error("open", "file", fn, "err", err)
+			return err
+		}
+		defer rd.Close()
+		st, err := rd.Stat()
+		if err != nil {
+			return err
+		}
+		inputs[idx], sizes[idx] = rd, uint64(st.Size())
+	}
+	layout, err := new_layout(option.Layout, option.Encoding)
+	if err != nil {
+		slog.Error("layout", "layout", option.Layout, "err", err)
+		return err
+	}
+	layout.SetAddressLimit(max(sizes[0], sizes[1]))
+	sample := make([]byte, uhd.DetectSampleSize)
+	n, _ := inputs[0].ReadAt(sample, 0)
+	layout.ResolveAuto(sample[:n])
+	d := newDiffer(os.Stdout, layout, inputs[0], inputs[1], sizes[0], sizes[1])
+	if err := d.Process(); err != nil {
+		return err
+	}
+	if option.Summary {
+		fmt.Fprintln(os.Stdout)
+		d.Summary()
+	}
+	return nil
+}
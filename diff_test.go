@@ -0,0 +1,105 @@
+package main
+
+import (
+	"bytes"
+	"io"
+	"testing"
+
+	"github.com/fatih/color"
+	"github.com/wtnb75/uhd/pkg/uhd"
+)
+
+func TestDiffer(t *testing.T) {
+	setLayoutOption(t)
+	oldNoColor := color.NoColor
+	color.NoColor = true
+	defer func() { color.NoColor = oldNoColor }()
+	option.Width = 8
+	a := []byte("0123456789abcdefghijklmnopqrstuvwxyz")
+	b := []byte("0123456789abcdefghijklmnopqrstUVwxyz!")
+	layout, err := uhd.ParseLayout("header,hexdump,printable", "utf-8", option.Width, option.Sep)
+	if err != nil {
+		t.Fatal("ParseLayout", "err", err)
+	}
+	buf := &bytes.Buffer{}
+	d := newDiffer(buf, layout, bytes.NewReader(a), bytes.NewReader(b), uint64(len(a)), uint64(len(b)))
+	if err := d.Process(); err != nil {
+		t.Fatal("process", "err", err)
+	}
+	d.Summary()
+	expected := "  00000000  30 31 32 33 34 35 36 37   01234567\n" +
+		"*\n" +
+		"  00000010  67 68 69 6A 6B 6C 6D 6E   ghijklmn\n" +
+		"- 00000018  6F 70 71 72 73 74 75 76   opqrstuv\n" +
+		"+ 00000018  6F 70 71 72 73 74 55 56   opqrstUV\n" +
+		"- 00000020  77 78 79 7A               wxyz\n" +
+		"+ 00000020  77 78 79 7A 21            wxyz!\n" +
+		"0000001E-0000001F 2 bytes\n" +
+		"00000024-00000024 1 bytes\n" +
+		"size: 36, 37\n" +
+		"2 ranges, 3 bytes differ\n"
+	if buf.String() != expected {
+		t.Errorf("unexpected output:\ngot:  %q\nwant: %q", buf.String(), expected)
+	}
+}
+
+func TestDiffer_SqueezeCount(t *testing.T) {
+	setLayoutOption(t)
+	oldNoColor := color.NoColor
+	color.NoColor = true
+	defer func() { color.NoColor = oldNoColor }()
+	option.Width = 8
+	option.SqueezeCount = true
+	// a run of zero-filled rows and a run of text rows
+	a := append(make([]byte, 40), "0123456789abcdefghijklmnopqrstuvwxyz"...)
+	b := bytes.Clone(a)
+	b[40] = 'X'
+	layout, err := uhd.ParseLayout("header,hexdump,printable", "utf-8", option.Width, option.Sep)
+	if err != nil {
+		t.Fatal("ParseLayout", "err", err)
+	}
+	buf := &bytes.Buffer{}
+	d := newDiffer(buf, layout, bytes.NewReader(a), bytes.NewReader(b), uint64(len(a)), uint64(len(b)))
+	if err := d.Process(); err != nil {
+		t.Fatal("process", "err", err)
+	}
+	expected := "  00000000  00 00 00 00 00 00 00 00   ........\n" +
+		"* (3 rows, 0x18 bytes of 00)\n" +
+		"  00000020  00 00 00 00 00 00 00 00   ........\n" +
+		"- 00000028  30 31 32 33 34 35 36 37   01234567\n" +
+		"+ 00000028  58 31 32 33 34 35 36 37   X1234567\n" +
+		"  00000030  38 39 61 62 63 64 65 66   89abcdef\n" +
+		"* (2 rows, 0x10 bytes)\n" +
+		"  00000048  77 78 79 7A               wxyz\n"
+	if buf.String() != expected {
+		t.Errorf("unexpected output:\ngot:  %q\nwant: %q", buf.String(), expected)
+	}
+}
+
+func TestDiffer_SummaryAddress(t *testing.T) {
+	setLayoutOption(t)
+	a := []byte("0123456789abcdefghijklmnopqrstuvwxyz")
+	b := []byte("0123456789abcdefghijklmnopqrstUVwxyz!")
+	layout, err := uhd.ParseLayout("header,hexdump,printable", "utf-8", option.Width, option.Sep)
+	if err != nil {
+		t.Fatal("ParseLayout", "err", err)
+	}
+	if err := layout.SetAddressFormat("dec"); err != nil {
+		t.Fatal("SetAddressFormat", "err", err)
+	}
+	layout.SetBaseAddress(1000)
+	buf := &bytes.Buffer{}
+	d := newDiffer(io.Discard, layout, bytes.NewReader(a), bytes.NewReader(b), uint64(len(a)), uint64(len(b)))
+	if err := d.Process(); err != nil {
+		t.Fatal("process", "err", err)
+	}
+	d.output = buf
+	d.Summary()
+	expected := "00001030-00001031 2 bytes\n" +
+		"00001036-00001036 1 bytes\n" +
+		"size: 36, 37\n" +
+		"2 ranges, 3 bytes differ\n"
+	if buf.String() != expected {
+		t.Errorf("unexpected output:\ngot:  %q\nwant: %q", buf.String(), expected)
+	}
+}
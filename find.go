@@ -0,0 +1,249 @@
+package main
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"io"
+	"log/slog"
+	"os"
+	"strconv"
+	"strings"
+
+	"github.com/fatih/color"
+	"github.com/wtnb75/uhd/pkg/uhd"
+)
+
+// wildcard is the pattern value of "??" in a hex pattern.
+const wildcard = -1
+
+const find_chunk_size = 64 * 1024
+
+type finder struct {
+	output  io.Writer
+	layout  *uhd.Layout
+	input   io.ReaderAt
+	size    uint64
+	pattern []int
+	context int
+	matches []byte_range
+}
+
+// parse_hex_pattern parses hex bytes like "EB ?? B4 09" or "EB??B409". "??"
+// matches any byte.
+func parse_hex_pattern(s string) ([]int, error) {
+	s = strings.Join(strings.Fields(s), "")
+	if s == "" || len(s)%2 != 0 {
+		return nil, fmt.Errorf("invalid hex pattern: %q", s)
+	}
+	res := make([]int, 0, len(s)/2)
+	for i := 0; i < len(s); i += 2 {
+		token := s[i : i+2]
+		if token == "??" {
+			res = append(res, wildcard)
+			continue
+		}
+		val, err := strconv.ParseUint(token, 16, 8)
+		if err != nil {
+			return nil, fmt.Errorf("invalid hex pattern: %q", token)
+		}
+		res = append(res, int(val))
+	}
+	return res, nil
+}
+
+// encode_pattern converts text into the bytes of the named encoding.
+func encode_pattern(text, name string) ([]int, error) {
+	encoded, err := uhd.EncodeText(text, name)
+	if err != nil {
+		return nil, fmt.Errorf("cannot encode %q in %s, use --find-hex: %w", text, name, err)
+	}
+	if len(encoded) == 0 {
+		return nil, errors.New("empty pattern")
+	}
+	res := make([]int, len(encoded))
+	for i, ch := range encoded {
+		res[i] = int(ch)
+	}
+	return res, nil
+}
+
+func (f *finder) match(p []byte) bool {
+	for i, ch := range f.pattern {
+		if ch != wildcard && byte(ch) != p[i] {
+			return false
+		}
+	}
+	return true
+}
+
+// Search finds the non-overlapping matches between start and end. Chunks
+// overlap by the pattern length so that a match crossing them is found.
+func (f *finder) Search(start, end uint64) error {
+	end = min(end, f.size)
+	plen := uint64(len(f.pattern))
+	buf := make([]byte, find_chunk_size+plen-1)
+	next := start
+	for pos := start; pos+plen <= end; pos += find_chunk_size {
+		n, err := f.input.ReadAt(buf[:min(uint64(len(buf)), end-pos)], int64(pos))
+		if err != nil && err != io.EOF {
+			return err
+		}
+		for i := 0; uint64(i)+plen <= uint64(n); i++ {
+			offset := pos + uint64(i)
+			if offset < next || !f.match(buf[i:]) {
+				continue
+			}
+			f.matches = append(f.matches, byte_range{offset, offset + plen})
+			next = offset + plen
+		}
+	}
+	slog.Debug("search", "start", start, "end", end, "matches", len(f.matches))
+	return nil
+}
+
+// marked returns the indexes of the matched bytes in a row.
+func (f *finder) marked(row uint64) []int {
+	width := uint64(option.Width)
+	res := []int{}
+	for _, m := range f.matches {
+		for offset := max(m.start, row*width); offset < min(m.end, (row+1)*width); offset++ {
+			res = append(res, int(offset-row*width))
+		}
+	}
+	return res
+}
+
+// groups returns the ranges of rows to show: the rows of each match and the
+// context rows around them, merged when they touch.
+func (f *finder) groups() []byte_range {
+	if f.size == 0 {
+		return nil
+	}
+	width := uint64(option.Width)
+	lastrow := (f.size - 1) / width
+	ctx := uint64(f.context)
+	res := []byte_range{}
+	for _, m := range f.matches {
+		first := m.start / width
+		first -= min(first, ctx)
+		last := min(lastrow, (m.end-1)/width+ctx)
+		if n := len(res); n != 0 && first <= res[n-1].end {
+			res[n-1].end = max(res[n-1].end, last+1)
+			continue
+		}
+		res = append(res, byte_range{first, last + 1})
+	}
+	return res
+}
+
+// Process prints the matching rows with their context. Groups of rows that
+// are not adjacent are separated by "--".
+func (f *finder) Process() error {
+	for idx, g := range f.groups() {
+		if idx != 0 {
+			fmt.Fprintln(f.output, "--")
+		}
+		rows, err := f.layout.RenderRows(f.input, f.size, g.start, int(g.end-g.start))
+		if err != nil {
+			return err
+		}
+		for i := range int(g.end - g.start) {
+			var row uhd.Row
+			if i < len(rows) {
+				row = rows[i]
+			}
+			idxs := f.marked(g.start + uint64(i))
+			if !color.NoColor {
+				fmt.Fprintln(f.output, f.layout.ComposeRow(row, idxs...))
+				continue
+			}
+			fmt.Fprintln(f.output, f.layout.ComposeRow(row))
+			if len(idxs) != 0 {
+				// mark the matches under the row
+				fmt.Fprintln(f.output, f.layout.MarkRow(row, idxs...))
+			}
+		}
+	}
+	return nil
+}
+
+func newFinder(output io.Writer, layout *uhd.Layout, input io.ReaderAt, size uint64, pattern []int, context int) *finder {
+	return &finder{
+		output:  output,
+		layout:  layout,
+		input:   input,
+		size:    size,
+		pattern: pattern,
+		context: context,
+	}
+}
+
+func do_find(filename string) error {
+	var input io.ReaderAt
+	var size uint64
+	if filename == "-" {
+		data, err := io.ReadAll(os.Stdin)
+		if err != nil {
+			return err
+		}
+		input, size = bytes.NewReader(data), uint64(len(data))
+	} else {
+		rd, err := os.Open(filename)
+		if err != nil {
+			slog.Error("open", "file", filename, "err", err)
+			return err
+		}
+		defer rd.Close()
+		st, err := rd.Stat()
+		if err != nil {
+			return err
+		}
+		input, size = rd, uint64(st.Size())
+	}
+	layout, err := new_layout(option.Layout, option.Encoding)
+	if err != nil {
+		slog.Error("layout", "layout", option.Layout, "err", err)
+		return err
+	}
+	layout.SetAddressLimit(size)
+	skip, err := strconv.ParseUint(option.Skip, 0, 64)
+	if err != nil {
+		slog.Error("invalid skip", "skip", option.Skip, "err", err)
+		return err
+	}
+	length, err := strconv.ParseUint(option.Length, 0, 63)
+	if err != nil {
+		slog.Error("invalid length", "length", option.Length, "err", err)
+		return err
+	}
+	end := size
+	if length != 0 {
+		end = min(size, skip+length)
+	}
+	sample := make([]byte, uhd.DetectSampleSize)
+	n, _ := input.ReadAt(sample, int64(min(skip, size)))
+	layout.ResolveAuto(sample[:n])
+	var pattern []int
+	if option.FindHex != "" {
+		pattern, err = parse_hex_pattern(option.FindHex)
+	} else {
+		encoding := strings.Split(option.Encoding, ",")[0]
+		if encoding == "auto" {
+			encoding, _ = uhd.DetectEncoding(sample[:n])
+		}
+		pattern, err = encode_pattern(option.Find, encoding)
+	}
+	if err != nil {
+		return err
+	}
+	f := newFinder(os.Stdout, layout, input, size, pattern, option.Context)
+	if err := f.Search(skip, end); err != nil {
+		return err
+	}
+	if len(f.matches) == 0 {
+		slog.Info("not found", "file", filename)
+		return nil
+	}
+	return f.Process()
+}
@@ -0,0 +1,115 @@
+package main
+
+import (
+	"bytes"
+	"slices"
+	"strings"
+	"testing"
+
+	"github.com/fatih/color"
+	"github.com/wtnb75/uhd/pkg/uhd"
+)
+
+func TestParseHexPattern(t *testing.T) {
+	cases := []struct {
+		input    string
+		expected []int
+	}{
+		{"EB ?? B4 09", []int{0xeb, wildcard, 0xb4, 0x09}},
+		{"eb??b409", []int{0xeb, wildcard, 0xb4, 0x09}},
+		{"00", []int{0}},
+	}
+	for _, c := range cases {
+		res, err := parse_hex_pattern(c.input)
+		if err != nil {
+			t.Errorf("%q: %v", c.input, err)
+		} else if !slices.Equal(res, c.expected) {
+			t.Errorf("%q: got %v, want %v", c.input, res, c.expected)
+		}
+	}
+	for _, input := range []string{"", "E", "EB ?", "XY"} {
+		if _, err := parse_hex_pattern(input); err == nil {
+			t.Errorf("%q: expected error", input)
+		}
+	}
+}
+
+//nolint:gosmopolitan
+func TestEncodePattern(t *testing.T) {
+	cases := []struct {
+		text     string
+		encoding string
+		expected []int
+	}{
+		{"あい", "utf-8", []int{0xe3, 0x81, 0x82, 0xe3, 0x81, 0x84}},
+		{"あい", "shift-jis", []int{0x82, 0xa0, 0x82, 0xa2}},
+		{"あい", "euc-jp", []int{0xa4, 0xa2, 0xa4, 0xa4}},
+		{"AB", "utf-16le", []int{0x41, 0, 0x42, 0}},
+		{"A", "utf-32", []int{0, 0, 0, 0x41}},
+		{"あ", "shift_jis-2004", []int{0x82, 0xa0}},
+		{"\U0001F600", "cesu-8", []int{0xed, 0xa0, 0xbd, 0xed, 0xb8, 0x80}},
+	}
+	for _, c := range cases {
+		res, err := encode_pattern(c.text, c.encoding)
+		if err != nil {
+			t.Errorf("%s: %v", c.encoding, err)
+		} else if !slices.Equal(res, c.expected) {
+			t.Errorf("%s: got %v, want %v", c.encoding, res, c.expected)
+		}
+	}
+	if _, err := encode_pattern("あ", "iso-8859-1"); err == nil {
+		t.Error("expected error for unencodable text")
+	}
+	// the stateful encodings name the encoding and point to --find-hex
+	for _, name := range []string{"iso-2022-jp", "utf-7", "ibm930"} {
+		_, err := encode_pattern("a", name)
+		if err == nil || !strings.Contains(err.Error(), name) || !strings.Contains(err.Error(), "--find-hex") {
+			t.Errorf("%s: unexpected error: %v", name, err)
+		}
+	}
+}
+
+func TestFinder(t *testing.T) {
+	setLayoutOption(t)
+	oldNoColor := color.NoColor
+	color.NoColor = true
+	defer func() { color.NoColor = oldNoColor }()
+	option.Width = 8
+	data := []byte("0123456789abcdefghijklmnopqrstuvwxyz0123456789ab")
+	layout, err := uhd.ParseLayout("header,hexdump,printable", "utf-8", option.Width, option.Sep)
+	if err != nil {
+		t.Fatal("ParseLayout", "err", err)
+	}
+	pattern, _ := parse_hex_pattern("6D ?? 6F")
+	buf := &bytes.Buffer{}
+	f := newFinder(buf, layout, bytes.NewReader(data), uint64(len(data)), pattern, 1)
+	if err := f.Search(0, uint64(len(data))); err != nil {
+		t.Fatal("search", "err", err)
+	}
+	if !slices.Equal(f.matches, []byte_range{{22, 25}}) {
+		t.Errorf("unexpected matches: %v", f.matches)
+	}
+	pattern, _ = encode_pattern("9ab", "utf-8")
+	f.pattern = pattern
+	f.matches = nil
+	if err := f.Search(0, uint64(len(data))); err != nil {
+		t.Fatal("search", "err", err)
+	}
+	if len(f.matches) != 2 {
+		t.Fatalf("unexpected matches: %v", f.matches)
+	}
+	if err := f.Process(); err != nil {
+		t.Fatal("process", "err", err)
+	}
+	expected := "00000000  30 31 32 33 34 35 36 37   01234567\n" +
+		"00000008  38 39 61 62 63 64 65 66   89abcdef\n" +
+		"             ^^ ^^ ^^                ^^^\n" +
+		"00000010  67 68 69 6A 6B 6C 6D 6E   ghijklmn\n" +
+		"--\n" +
+		"00000020  77 78 79 7A 30 31 32 33   wxyz0123\n" +
+		"00000028  34 35 36 37 38 39 61 62   456789ab\n" +
+		"                         ^^ ^^ ^^        ^^^\n"
+	if buf.String() != expected {
+		t.Errorf("unexpected output:\ngot:  %q\nwant: %q", buf.String(), expected)
+	}
+}
@@ -6,6 +6,7 @@ require (
 	github.com/acomagu/bufpipe v1.0.4
 	github.com/fatih/color v1.19.0
 	github.com/jessevdk/go-flags v1.6.1
+	golang.org/x/term v0.45.0
 	golang.org/x/text v0.41.0
 )
 
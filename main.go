@@ -7,65 +7,122 @@ import (
 	"log/slog"
 	"os"
 	"path/filepath"
-	"strings"
-	"sync"
+	"strconv"
 
-	"github.com/acomagu/bufpipe"
 	"github.com/fatih/color"
 	"github.com/jessevdk/go-flags"
-	"golang.org/x/text/encoding/charmap"
+	"github.com/wtnb75/uhd/pkg/uhd"
 )
 
 //go:embed .github/skills/uhd-cli/SKILL.md
 var skillContent []byte
 
 var option struct {
-	Verbose      bool   `short:"v" long:"verbose" description:"Enable verbose logging"`
-	Encoding     string `long:"encoding" default:"utf-8"`
-	Width        int    `long:"width" default:"16"`
-	Sep          int    `long:"sep" default:"8"`
-	Layout       string `long:"layout" default:"jhd" choice:"hexdump" choice:"jhd" choice:"bytes"`
-	ListCode     bool   `short:"l" long:"list-codes" description:"list encoding"`
-	NoColor      bool   `long:"no-color" description:"disable color output"`
-	InstallSkill bool   `long:"install-skill" description:"install Copilot skill to user skill directory"`
-	SkillTarget  string `long:"skill-target" default:"copilot" choice:"copilot" choice:"agents" choice:"claude" description:"target skill directory (~/.copilot, ~/.agents, ~/.claude)"`
-	Version      bool   `short:"V" long:"version" description:"show version and exit"`
+	Verbose       bool   `short:"v" long:"verbose" description:"Enable verbose logging"`
+	Encoding      string `long:"encoding" default:"utf-8" description:"text encoding (auto to detect), comma-separated for one printable column each"`
+	Width         int    `long:"width" default:"16"`
+	Sep           int    `long:"sep" default:"8"`
+	Skip          string `short:"s" long:"skip" default:"0" description:"start at offset (0x prefix for hex)"`
+	Length        string `short:"n" long:"length" default:"0" description:"stop after length bytes, 0 for all (0x prefix for hex)"`
+	Output        string `long:"output" default:"text" choice:"text" choice:"json" choice:"jsonl" choice:"html" choice:"c" choice:"go" choice:"rust" choice:"python" choice:"js" description:"output format"`
+	Layout        string `long:"layout" default:"jhd" description:"jhd, hexdump, bytes or column list (e.g. header,hexdump_lower,printable:shift-jis)"`
+	ListCode      bool   `short:"l" long:"list-codes" description:"list encoding"`
+	Revert        bool   `short:"r" long:"revert" description:"convert hexdump into binary"`
+	TUI           bool   `long:"tui" description:"interactive full-screen viewer"`
+	Diff          bool   `long:"diff" description:"compare two files"`
+	Summary       bool   `long:"summary" description:"list differing ranges after --diff"`
+	Find          string `long:"find" description:"show rows containing text, encoded with --encoding"`
+	FindHex       string `long:"find-hex" description:"show rows containing hex bytes, ?? for any byte (e.g. \"EB ?? B4 09\")"`
+	Context       int    `short:"C" long:"context" default:"0" description:"rows of context around --find matches"`
+	Components    bool   `long:"components" description:"show the code points of grapheme clusters one by one"`
+	ControlStyle  string `long:"control-style" default:"dots" choice:"dots" choice:"pictures" choice:"caret" choice:"names" description:"how control characters are shown"`
+	AddressFormat string `long:"address-format" default:"hex" choice:"hex" choice:"dec" choice:"oct" choice:"none" description:"radix of the offset column"`
+	BaseAddress   string `long:"base-address" default:"0" description:"add to the offsets shown, e.g. a load address (0x prefix for hex)"`
+	OtherRadix    bool   `long:"other-radix" description:"add a column with the offset in decimal, or in hex for --address-format dec/oct"`
+	NoSqueeze     bool   `long:"no-squeeze" description:"show every row instead of squeezing repeated rows into *"`
+	SqueezeCount  bool   `long:"squeeze-count" description:"show the rows and bytes squeezed, e.g. \"* (3 rows, 0x30 bytes of 00)\""`
+	NoColor       bool   `long:"no-color" description:"disable color output"`
+	InstallSkill  bool   `long:"install-skill" description:"install Copilot skill to user skill directory"`
+	SkillTarget   string `long:"skill-target" default:"copilot" choice:"copilot" choice:"agents" choice:"claude" description:"target skill directory (~/.copilot, ~/.agents, ~/.claude)"`
+	Version       bool   `short:"V" long:"version" description:"show version and exit"`
 }
 
-type column struct {
-	name  string
-	width int
-}
-
-func get_layout(predefined string) []column {
-	switch predefined {
-	case "jhd":
-		return []column{
-			{"header", 9},
-			{"hexdump", 3*(option.Width) + option.Width/option.Sep + (option.Width / 8) + 1},
-			{"printable", option.Width},
+// new_layout parses a layout with the options for all columns.
+func new_layout(spec, encoding string) (*uhd.Layout, error) {
+	layout, err := uhd.ParseLayout(spec, encoding, option.Width, option.Sep)
+	if err != nil {
+		return nil, err
+	}
+	if option.Components {
+		layout.ShowComponents()
+	}
+	if option.ControlStyle != "" {
+		if err := layout.SetControlStyle(option.ControlStyle); err != nil {
+			return nil, err
 		}
-	case "hexdump":
-		return []column{
-			{"header", 9},
-			{"hexdump_lower", 3*(option.Width) + option.Width/option.Sep + (option.Width / 8) + 1},
-			{"printable_pipe", option.Width + 2},
+	}
+	if option.AddressFormat != "" {
+		if err := layout.SetAddressFormat(option.AddressFormat); err != nil {
+			return nil, err
 		}
-	case "bytes":
-		return []column{
-			{"header", 9},
-			{"hexbytes_lower", 6*option.Width + 1},
-			{"printable", option.Width},
+	}
+	if option.OtherRadix {
+		layout.AddOtherRadix()
+	}
+	if option.BaseAddress != "" {
+		base, err := strconv.ParseUint(option.BaseAddress, 0, 64)
+		if err != nil {
+			return nil, fmt.Errorf("invalid base address: %q", option.BaseAddress)
 		}
+		layout.SetBaseAddress(base)
+	}
+	return layout, nil
+}
+
+// skip_input moves rd forward by skip bytes. Seekable files are seeked and
+// other inputs (pipes, terminals) are read and discarded.
+func skip_input(rd *os.File, skip uint64) error {
+	if skip == 0 {
+		return nil
+	}
+	if _, err := rd.Seek(int64(skip), io.SeekStart); err == nil {
+		return nil
+	} else {
+		slog.Debug("seek failed, discarding", "skip", skip, "err", err)
+	}
+	discarded, err := io.CopyN(io.Discard, rd, int64(skip))
+	if err == io.EOF {
+		slog.Debug("skip beyond eof", "skip", skip, "discarded", discarded)
+		return nil
 	}
-	return []column{}
+	return err
 }
 
 func do_uhd(filename string) (err error) {
 	var rd *os.File
-	var layout = get_layout(option.Layout)
+	if _, err := uhd.ParseLayout(option.Layout, option.Encoding, option.Width, option.Sep); err != nil {
+		slog.Error("layout", "layout", option.Layout, "err", err)
+		return err
+	}
+	skip, err := strconv.ParseUint(option.Skip, 0, 64)
+	if err != nil {
+		slog.Error("invalid skip", "skip", option.Skip, "err", err)
+		return err
+	}
+	length, err := strconv.ParseUint(option.Length, 0, 63)
+	if err != nil {
+		slog.Error("invalid length", "length", option.Length, "err", err)
+		return err
+	}
+	base, err := strconv.ParseUint(option.BaseAddress, 0, 64)
+	if err != nil {
+		slog.Error("invalid base address", "base", option.BaseAddress, "err", err)
+		return err
+	}
+	title := filename
 	if filename == "-" {
 		rd = os.Stdin
+		title = "(stdin)"
 	} else {
 		rd, err = os.Open(filename)
 		if err != nil {
@@ -74,61 +131,87 @@ func do_uhd(filename string) (err error) {
 		}
 		defer rd.Close()
 	}
-	widths := make([]int, 0, len(layout))
-	writers := make([]io.Writer, 0, len(layout))
-	readers := make([]io.Reader, 0, len(layout))
-	var dupidx int
-	for idx, col := range layout {
-		r, w := bufpipe.New(nil)
-		switch col.name {
-		case "header":
-			writers = append(writers, NewHeader(w, option.Width))
-		case "header_lower":
-			writers = append(writers, NewHeaderLower(w, option.Width))
-		case "hexdump":
-			writers = append(writers, NewHexdump(w, option.Width, option.Sep))
-			dupidx = idx
-		case "hexdump_lower":
-			writers = append(writers, NewHexdumpLower(w, option.Width, option.Sep))
-			dupidx = idx
-		case "hexbytes":
-			writers = append(writers, NewHexbytes(w, option.Width))
-		case "hexbytes_lower":
-			writers = append(writers, NewHexbytesLower(w, option.Width))
-		case "printable":
-			writers = append(writers, NewPrintable(w, option.Encoding, option.Width))
-		case "printable_pipe":
-			writers = append(writers, NewPrintableSep(w, option.Encoding, option.Width, "|", "|"))
+	// the size of a file widens the offset column for large files
+	var size uint64
+	if st, err := rd.Stat(); err == nil && st.Mode().IsRegular() {
+		size = uint64(st.Size()) - min(skip, uint64(st.Size()))
+		if length != 0 {
+			size = min(size, length)
 		}
-		readers = append(readers, r)
-		widths = append(widths, col.width)
-	}
-	wr := io.MultiWriter(writers...)
-	pst := NewPaster(os.Stdout, dupidx, readers...)
-	wg := &sync.WaitGroup{}
-	wg.Go(func() {
-		slog.Debug("widths", "values", widths)
-		if err := pst.Process(widths...); err != nil {
-			slog.Error("paster", "err", err)
-		}
-		slog.Debug("finished", "file", filename)
+	}
+	if err = skip_input(rd, skip); err != nil {
+		slog.Error("skip", "file", filename, "skip", skip, "err", err)
+		return err
+	}
+	var input io.Reader = rd
+	if length != 0 {
+		input = io.LimitReader(rd, int64(length))
+	}
+	wr := uhd.NewDumper(os.Stdout, uhd.Options{
+		Layout:        option.Layout,
+		Encoding:      option.Encoding,
+		Width:         option.Width,
+		Sep:           option.Sep,
+		Offset:        skip,
+		Format:        option.Output,
+		Title:         title,
+		Components:    option.Components,
+		ControlStyle:  option.ControlStyle,
+		AddressFormat: option.AddressFormat,
+		BaseAddress:   base,
+		OtherRadix:    option.OtherRadix,
+		Size:          size,
+		NoSqueeze:     option.NoSqueeze,
+		SqueezeCount:  option.SqueezeCount,
 	})
-	written, err := io.Copy(wr, rd)
+	written, err := io.Copy(wr, input)
 	slog.Debug("copy", "file", filename, "written", written, "err", err)
 	if err != nil {
 		slog.Error("copy", "file", filename, "err", err)
 	}
-	for _, w := range writers {
-		if closer, ok := w.(io.Closer); ok {
-			if err := closer.Close(); err != nil {
-				slog.Error("close writer", "file", filename, "err", err)
-			}
-		}
+	if err := wr.Close(); err != nil {
+		slog.Error("close", "file", filename, "err", err)
+		return err
 	}
-	wg.Wait()
 	return nil
 }
 
+func do_revert(filename string) (err error) {
+	var rd *os.File
+	if filename == "-" {
+		rd = os.Stdin
+	} else {
+		rd, err = os.Open(filename)
+		if err != nil {
+			slog.Error("open", "file", filename, "err", err)
+			return err
+		}
+		defer rd.Close()
+	}
+	wr := uhd.NewHexrev(os.Stdout)
+	wr.SetSep(option.Sep)
+	if err := wr.SetAddressFormat(option.AddressFormat); err != nil {
+		slog.Error("address format", "format", option.AddressFormat, "err", err)
+		return err
+	}
+	base, err := strconv.ParseUint(option.BaseAddress, 0, 64)
+	if err != nil {
+		slog.Error("invalid base address", "base", option.BaseAddress, "err", err)
+		return err
+	}
+	wr.SetBaseAddress(base)
+	if option.OtherRadix {
+		wr.SkipOtherRadix()
+	}
+	written, err := io.Copy(wr, rd)
+	slog.Debug("copy", "file", filename, "written", written, "err", err)
+	if err != nil {
+		slog.Error("copy", "file", filename, "err", err)
+		return err
+	}
+	return wr.Close()
+}
+
 func install_skill() error {
 	home, err := os.UserHomeDir()
 	if err != nil {
@@ -170,38 +253,43 @@ func main() {
 		slog.SetLogLoggerLevel(slog.LevelDebug)
 	}
 	if option.ListCode {
-		fmt.Println("utf-8, utf8")
-		fmt.Println("utf-16, utf16, utf-16be, utf16be, utf-16le, utf16le")
-		fmt.Println("utf-32, utf32, utf-32be, utf32be, utf-32le, utf32le")
-		fmt.Println("euc-jp, eucjp")
-		fmt.Println("euc-kr, euckr")
-		fmt.Println("euc-cn, euccn, gb18030")
-		fmt.Println("big5")
-		fmt.Println("shift-jis, sjis, shiftjis, cp932, cp-932, windows-31j")
-		for _, cm := range charmap.All {
-			name := fmt.Sprintf("%s", cm)
-			if strings.Contains(name, "enc=") {
-				tok := strings.SplitN(name, "enc=", 2)
-				if len(tok) == 2 {
-					name = strings.Trim(tok[1], "\"")
-				}
-			}
-			fmt.Println(name)
+		for _, line := range uhd.Encodings() {
+			fmt.Println(line)
 		}
 		return
 	}
+	if option.Diff {
+		if err := do_diff(parsed); err != nil {
+			slog.Error("diff", "files", parsed, "err", err)
+		}
+		return
+	}
+	process := do_uhd
+	if option.Revert {
+		process = do_revert
+	} else if option.TUI {
+		process = do_tui
+	} else if option.Find != "" || option.FindHex != "" {
+		process = do_find
+	}
+	failed := false
 	if len(parsed) == 0 {
-		err := do_uhd("-")
+		err := process("-")
 		if err != nil {
 			slog.Error("uhd", "file", "(stdin)", "err", err)
+			failed = true
 		}
 	} else {
 		for _, fn := range parsed {
-			err := do_uhd(fn)
+			err := process(fn)
 			if err != nil {
 				slog.Error("uhd", "file", fn, "err", err)
+				failed = true
 				// continue
 			}
 		}
 	}
+	if failed {
+		os.Exit(1)
+	}
 }
@@ -7,6 +7,14 @@ import (
 	"testing"
 )
 
+func setLayoutOption(t *testing.T) {
+	oldOption := option
+	t.Cleanup(func() { option = oldOption })
+	option.Width = 16
+	option.Sep = 8
+	option.Encoding = "utf-8"
+}
+
 func TestVersionFlag(t *testing.T) {
 	oldArgs := os.Args
 	oldStdout := os.Stdout
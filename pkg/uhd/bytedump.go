@@ -0,0 +1,116 @@
+package uhd
+
+import (
+	"fmt"
+	"io"
+	"log/slog"
+	"strings"
+)
+
+// Bytedump writes each byte in octal, decimal or binary like Hexdump, with an
+// extra space every sep bytes.
+type Bytedump struct {
+	output io.Writer
+	cur    uint64
+	width  int
+	sep    int
+	format func(b byte) string
+}
+
+func (h *Bytedump) Write(p []byte) (n int, err error) {
+	for i, ch := range p {
+		fmt.Fprint(h.output, " "+h.format(ch))
+		c := h.cur + uint64(i)
+		cw := int(c % uint64(h.width))
+		if cw == h.width-1 {
+			fmt.Fprint(h.output, "\n")
+		} else if cw%h.sep == h.sep-1 {
+			fmt.Fprint(h.output, " ")
+		}
+	}
+	h.cur += uint64(len(p))
+	return len(p), nil
+}
+
+// SetOffset starts the dump at offset, leaving blanks for the bytes before it
+// in the first row.
+func (h *Bytedump) SetOffset(offset uint64) {
+	h.cur = offset
+	blank := strings.Repeat(" ", len(h.format(0))+1)
+	for cw := 0; cw < int(offset%uint64(h.width)); cw++ {
+		fmt.Fprint(h.output, blank)
+		if cw%h.sep == h.sep-1 {
+			fmt.Fprint(h.output, " ")
+		}
+	}
+}
+
+func (h *Bytedump) Close() (err error) {
+	if h.cur%uint64(h.width) != 0 {
+		fmt.Fprint(h.output, "\n")
+	}
+	if closer, ok := h.output.(io.Closer); ok {
+		if err := closer.Close(); err != nil {
+			slog.Error("close writer", "err", err)
+			return err
+		}
+	}
+	return nil
+}
+
+// binary_digits returns the bits of b, with "_" between groups of group bits
+// counted from the lowest bit, or no separator if group is 0.
+func binary_digits(b byte, group int) string {
+	bits := fmt.Sprintf("%08b", b)
+	if group <= 0 || group >= 8 {
+		return bits
+	}
+	var sb strings.Builder
+	for idx, bit := range bits {
+		if idx != 0 && (8-idx)%group == 0 {
+			sb.WriteString("_")
+		}
+		sb.WriteRune(bit)
+	}
+	return sb.String()
+}
+
+// byte_digits returns the cells of a byte in an octal, decimal or binary
+// column.
+func byte_digits(col column) int {
+	if col.name == "binary" {
+		return len(binary_digits(0, col.group))
+	}
+	return 3
+}
+
+// NewOctal creates a Bytedump of three octal digits per byte, like od -b.
+func NewOctal(output io.Writer, width int, sep int) *Bytedump {
+	return &Bytedump{
+		output: output,
+		width:  width,
+		sep:    sep,
+		format: func(b byte) string { return fmt.Sprintf("%03o", b) },
+	}
+}
+
+// NewDecimal creates a Bytedump of right-aligned decimal bytes, like od -t u1.
+func NewDecimal(output io.Writer, width int, sep int) *Bytedump {
+	return &Bytedump{
+		output: output,
+		width:  width,
+		sep:    sep,
+		format: func(b byte) string { return fmt.Sprintf("%3d", b) },
+	}
+}
+
+// NewBinary creates a Bytedump of eight bits per byte, like xxd -b. The bits
+// are split into groups of group bits if group is not 0.
+func NewBinary(output io.Writer, width int, sep int, group int) *Bytedump {
+	return &Bytedump{
+		output: output,
+		width:  width,
+		sep:    sep,
+		format: func(b byte) string { return binary_digits(b, group) },
+	}
+}
@@ -0,0 +1,59 @@
+package uhd
+
+import (
+	"bytes"
+	"testing"
+)
+
+func TestBytedump(t *testing.T) {
+	input := []byte("A\x00\xff\n\x05")
+	tests := []struct {
+		name     string
+		wr       func(buf *bytes.Buffer) *Bytedump
+		expected string
+	}{
+		{"octal", func(buf *bytes.Buffer) *Bytedump { return NewOctal(buf, 4, 2) }, " 101 000  377 012\n 005\n"},
+		{"decimal", func(buf *bytes.Buffer) *Bytedump { return NewDecimal(buf, 4, 2) }, "  65   0  255  10\n   5\n"},
+		{"binary", func(buf *bytes.Buffer) *Bytedump { return NewBinary(buf, 4, 4, 0) },
+			" 01000001 00000000 11111111 00001010\n 00000101\n"},
+		{"binary:group=3", func(buf *bytes.Buffer) *Bytedump { return NewBinary(buf, 4, 4, 3) },
+			" 01_000_001 00_000_000 11_111_111 00_001_010\n 00_000_101\n"},
+	}
+	for _, tt := range tests {
+		buf := &bytes.Buffer{}
+		h := tt.wr(buf)
+		if _, err := h.Write(input); err != nil {
+			t.Fatal("write", "err", err)
+		}
+		if err := h.Close(); err != nil {
+			t.Fatal("close", "err", err)
+		}
+		if buf.String() != tt.expected {
+			t.Errorf("unexpected output of %s:\ngot:  %q\nwant: %q", tt.name, buf.String(), tt.expected)
+		}
+	}
+}
+
+func TestBytedump_SetOffset(t *testing.T) {
+	buf := &bytes.Buffer{}
+	h := NewOctal(buf, 4, 2)
+	h.SetOffset(0x13)
+	if _, err := h.Write([]byte("ab")); err != nil {
+		t.Fatal("write", "err", err)
+	}
+	if err := h.Close(); err != nil {
+		t.Fatal("close", "err", err)
+	}
+	expected := "              141\n 142\n"
+	if buf.String() != expected {
+		t.Errorf("unexpected output:\ngot:  %q\nwant: %q", buf.String(), expected)
+	}
+}
+
+func TestBinaryDigits(t *testing.T) {
+	for group, expected := range map[int]string{0: "10100101", 1: "1_0_1_0_0_1_0_1", 2: "10_10_01_01", 4: "1010_0101", 5: "101_00101", 8: "10100101"} {
+		if got := binary_digits(0xa5, group); got != expected {
+			t.Errorf("unexpected bits of group %d:\ngot:  %q\nwant: %q", group, got, expected)
+		}
+	}
+}
@@ -0,0 +1,105 @@
+package uhd
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/fatih/color"
+)
+
+// control_styles lists how a printable column shows the C0 controls and DEL:
+// as "." (dots), as Unicode Control Pictures (pictures, ␀ ␊), in caret
+// notation (caret, ^@ ^J) or by their abbreviated names (names, NUL LF).
+var control_styles = []string{"dots", "pictures", "caret", "names"}
+
+var control_names = [...]string{
+	"NUL", "SOH", "STX", "ETX", "EOT", "ENQ", "ACK", "BEL",
+	"BS", "HT", "LF", "VT", "FF", "CR", "SO", "SI",
+	"DLE", "DC1", "DC2", "DC3", "DC4", "NAK", "SYN", "ETB",
+	"CAN", "EM", "SUB", "ESC", "FS", "GS", "RS", "US",
+}
+
+func valid_control_style(style string) bool {
+	return slices.Contains(control_styles, style)
+}
+
+// control_cells returns the most cells that style takes for one byte.
+func control_cells(style string) int {
+	switch style {
+	case "caret":
+		return 2
+	case "names":
+		return 3
+	}
+	return 1
+}
+
+// control_text returns how style shows the control r, or "" if r is not a
+// C0 control or DEL or if style shows dots.
+func control_text(style string, r rune) string {
+	if !(0 <= r && r < 0x20) && r != 0x7f {
+		return ""
+	}
+	switch style {
+	case "pictures":
+		if r == 0x7f {
+			return "␡"
+		}
+		return string(0x2400 + r)
+	case "caret":
+		return "^" + string(r^0x40)
+	case "names":
+		if r == 0x7f {
+			return "DEL"
+		}
+		return control_names[r]
+	}
+	return ""
+}
+
+// show_control shows the text s of a control of size bytes.
+func (h *Printable) show_control(s string, size int) {
+	h.trace.add("control", s, min(utf8.RuneCountInString(s), size))
+	fmt.Fprint(h.output, color.BlueString(s))
+}
+
+// control shows the control r of size bytes, padded with "_". It shows
+// nothing and returns false if r is not a control or the style is dots.
+func (h *Printable) control(r rune, size int) bool {
+	s := control_text(h.control_style, r)
+	if s == "" {
+		return false
+	}
+	h.show_control(s, size)
+	h.pad2(size - utf8.RuneCountInString(s))
+	return true
+}
+
+// put_control is put for a character r that is not printable: a control in
+// the control style, and anything else as ".".
+func (h *Printable) put_control(r rune, size int) {
+	s := control_text(h.control_style, r)
+	h.place(s, size, utf8.RuneCountInString(s), func(s string) { h.show_control(s, size) })
+}
+
+// SetControlStyle sets the style of the C0 controls and DEL in the printable
+// columns that do not have one: "dots", "pictures", "caret" or "names". The
+// columns are widened to fit the longest form.
+func (l *Layout) SetControlStyle(style string) error {
+	style = strings.ToLower(style)
+	if !valid_control_style(style) {
+		return fmt.Errorf("invalid control style: %q", style)
+	}
+	for idx, col := range l.columns {
+		if col.name != "printable" || col.control != "" {
+			continue
+		}
+		gap := col.width - column_width(col, l.width, l.sep)
+		col.control = style
+		l.columns[idx].control = style
+		l.columns[idx].width = column_width(col, l.width, l.sep) + gap
+	}
+	return nil
+}
@@ -0,0 +1,406 @@
+package uhd
+
+import (
+	"bytes"
+	"log/slog"
+	"slices"
+	"unicode"
+	"unicode/utf8"
+
+	"golang.org/x/text/encoding"
+	"golang.org/x/text/encoding/japanese"
+	"golang.org/x/text/encoding/korean"
+	"golang.org/x/text/encoding/simplifiedchinese"
+	"golang.org/x/text/encoding/traditionalchinese"
+)
+
+// DetectSampleSize is the number of bytes DetectEncoding needs at most.
+const DetectSampleSize = 64 * 1024
+
+type detect_candidate struct {
+	name string
+	enc  encoding.Encoding
+	// next returns the length of the character at the top of p, 0 if it is
+	// not a valid sequence, or -1 if p ends in the middle of it.
+	next func(p []byte) int
+	// weight tells how plausible a decoded multibyte character is.
+	weight func(r rune, seq []byte) float64
+}
+
+func in_range(ch, start, end byte) bool {
+	return start <= ch && ch <= end
+}
+
+func next_sjis(p []byte) int {
+	if sjis_single(p[0]) {
+		return 1
+	}
+	if !in_range(p[0], 0x81, 0x9f) && !in_range(p[0], 0xe0, 0xfc) {
+		return 0
+	}
+	if len(p) < 2 {
+		return -1
+	}
+	if (in_range(p[1], 0x40, 0x7e) || in_range(p[1], 0x80, 0xfc)) && valid_sjis(p[0], p[1]) {
+		return 2
+	}
+	return 0
+}
+
+// next_eucjp is eucjp_size, but rejects the unassigned rows of JIS X 0208.
+func next_eucjp(p []byte) int {
+	size := eucjp_size(p)
+	if size == 2 && p[0] != 0x8e && !valid_eucjp(p[0], p[1]) {
+		return 0
+	}
+	return size
+}
+
+func next_euckr(p []byte) int {
+	if p[0] < 0x80 {
+		return 1
+	}
+	if !in_range(p[0], 0xa1, 0xfe) {
+		return 0
+	}
+	if len(p) < 2 {
+		return -1
+	}
+	if in_range(p[1], 0xa1, 0xfe) && valid_euckr(p[0], p[1]) {
+		return 2
+	}
+	return 0
+}
+
+// next_gb18030 is gb18030_size, but rejects the GB2312 area outside of
+// GB2312 as it is rare in real text.
+func next_gb18030(p []byte) int {
+	size := gb18030_size(p)
+	if size == 2 && in_range(p[0], 0xa1, 0xfe) && in_range(p[1], 0xa1, 0xfe) && !valid_euccn(p[0], p[1]) {
+		return 0
+	}
+	return size
+}
+
+func next_big5(p []byte) int {
+	if p[0] < 0x80 {
+		return 1
+	}
+	if !in_range(p[0], 0xa1, 0xf9) {
+		return 0
+	}
+	if len(p) < 2 {
+		return -1
+	}
+	if in_range(p[1], 0x40, 0x7e) || in_range(p[1], 0xa1, 0xfe) {
+		return 2
+	}
+	return 0
+}
+
+func is_cjk_symbol(r rune) bool {
+	return (0x3000 <= r && r <= 0x303f) || (0xff01 <= r && r <= 0xff5e)
+}
+
+func weight_ja(r rune, seq []byte) float64 {
+	switch {
+	case unicode.In(r, unicode.Hiragana, unicode.Katakana) && !(0xff61 <= r && r <= 0xff9f):
+		return 1.0
+	case is_cjk_symbol(r):
+		return 0.8
+	case unicode.Is(unicode.Han, r):
+		// japanese text without kana is rare
+		return 0.6
+	case 0xff61 <= r && r <= 0xff9f:
+		// half-width katakana
+		return 0.5
+	}
+	return 0.3
+}
+
+func weight_ko(r rune, seq []byte) float64 {
+	switch {
+	case 0xac00 <= r && r <= 0xd7a3:
+		return 1.0
+	case is_cjk_symbol(r):
+		return 0.6
+	}
+	return 0.3
+}
+
+// weight_uhc is weight_ko with the characters outside of EUC-KR counted
+// lower: the extended hangul of CP949 are rare, and Shift_JIS text, whose
+// lead bytes are mostly below 0xA1, reads as them.
+func weight_uhc(r rune, seq []byte) float64 {
+	if len(seq) == 2 && (seq[0] < 0xa1 || seq[1] < 0xa1) {
+		return 0.5
+	}
+	return weight_ko(r, seq)
+}
+
+func weight_gb(r rune, seq []byte) float64 {
+	gb2312 := len(seq) == 2 && in_range(seq[0], 0xa1, 0xf7) && in_range(seq[1], 0xa1, 0xfe)
+	switch {
+	case unicode.Is(unicode.Han, r) && gb2312:
+		return 0.8
+	case is_cjk_symbol(r):
+		return 0.6
+	case unicode.Is(unicode.Han, r) && len(seq) == 2:
+		// GBK extension
+		return 0.4
+	}
+	return 0.3
+}
+
+func weight_big5(r rune, seq []byte) float64 {
+	code := uint(seq[0])<<8 | uint(seq[1])
+	switch {
+	case unicode.Is(unicode.Han, r) && 0xa440 <= code && code <= 0xc67e:
+		// frequently used characters
+		return 0.8
+	case is_cjk_symbol(r):
+		return 0.6
+	case unicode.Is(unicode.Han, r):
+		return 0.5
+	}
+	return 0.3
+}
+
+var detect_candidates = []detect_candidate{
+	{"shift-jis", japanese.ShiftJIS, next_sjis, weight_ja},
+	// only wins over shift-jis with the characters of Windows
+	{"cp932", japanese.ShiftJIS, sjis_size, weight_ja},
+	{"euc-jp", japanese.EUCJP, next_eucjp, weight_ja},
+	{"euc-kr", korean.EUCKR, next_euckr, weight_ko},
+	// only wins over euc-kr with the extended hangul of Windows
+	{"cp949", korean.EUCKR, uhc_size, weight_uhc},
+	{"gb18030", simplifiedchinese.GB18030, next_gb18030, weight_gb},
+	{"big5", traditionalchinese.Big5, next_big5, weight_big5},
+}
+
+// score_candidate returns the average plausibility of the multibyte
+// characters in p, with invalid sequences counted as a penalty.
+func score_candidate(c detect_candidate, p []byte) float64 {
+	dec := c.enc.NewDecoder()
+	total, count := 0.0, 0
+	for len(p) > 0 {
+		size := c.next(p)
+		if size == -1 {
+			break
+		}
+		if size == 0 {
+			total -= 2
+			count++
+			p = p[1:]
+			continue
+		}
+		if size > 1 {
+			count++
+			u8, err := dec.Bytes(p[:size])
+			r, _ := utf8.DecodeRune(u8)
+			if err != nil || r == utf8.RuneError || !unicode.IsPrint(r) {
+				total -= 2
+			} else {
+				total += c.weight(r, p[:size])
+			}
+		}
+		p = p[size:]
+	}
+	if count == 0 {
+		return 0
+	}
+	return total / float64(count)
+}
+
+func score_utf8(p []byte) (float64, int) {
+	total, count := 0.0, 0
+	for len(p) > 0 {
+		r, size := utf8.DecodeRune(p)
+		if r == utf8.RuneError && size == 1 {
+			if !utf8.FullRune(p) {
+				break
+			}
+			total -= 2
+			count++
+		} else if size > 1 {
+			count++
+			if unicode.IsPrint(r) {
+				total += 1.0
+			} else {
+				total += 0.5
+			}
+		}
+		p = p[size:]
+	}
+	if count == 0 {
+		return 0, 0
+	}
+	return total / float64(count), count
+}
+
+// score_utf16 is like score_candidate for BOM-less UTF-16. Text in latin
+// script is found by detect_utf16_32, so this favours CJK text.
+func score_utf16(p []byte, lendian bool) float64 {
+	total, count, euclike := 0.0, 0, 0
+	for len(p) >= 2 {
+		if in_range(p[0], 0xa1, 0xfe) && in_range(p[1], 0xa1, 0xfe) {
+			euclike++
+		}
+		code, _ := getcode_utf16(p, lendian)
+		size := 2
+		r := rune(code)
+		if code&0xfc00 == 0xd800 {
+			code2, err := getcode_utf16(p[2:], lendian)
+			if err != nil {
+				break
+			}
+			if code2&0xfc00 == 0xdc00 {
+				r = 0x10000 + rune((code&0x3ff)<<10|(code2&0x3ff))
+				size = 4
+			} else {
+				r = utf8.RuneError
+			}
+		}
+		count++
+		switch {
+		case r == utf8.RuneError, !unicode.IsPrint(r) && !unicode.IsSpace(r):
+			total -= 2
+		case r < 0x80:
+			total += 1.0
+		case unicode.In(r, unicode.Hiragana, unicode.Katakana), 0xac00 <= r && r <= 0xd7a3:
+			total += 1.0
+		case is_cjk_symbol(r):
+			total += 0.8
+		case unicode.Is(unicode.Han, r):
+			total += 0.6
+		default:
+			total += 0.2
+		}
+		p = p[size:]
+	}
+	if count == 0 {
+		return 0
+	}
+	if euclike*10 > count*7 {
+		// EUC-KR/GB2312 text reads as hangul in UTF-16BE
+		total /= 2
+	}
+	return total / float64(count)
+}
+
+// detect_utf16_32 guesses BOM-less UTF-16/32 from where the zero bytes are.
+func detect_utf16_32(p []byte) (string, float64) {
+	if len(p) < 8 {
+		return "", 0
+	}
+	var zeros [4]int
+	for i, ch := range p {
+		if ch == 0 {
+			zeros[i%4]++
+		}
+	}
+	quarter := float64(len(p) / 4)
+	ratio := func(idx ...int) float64 {
+		n := 0
+		for _, i := range idx {
+			n += zeros[i]
+		}
+		return float64(n) / (quarter * float64(len(idx)))
+	}
+	switch {
+	case ratio(2, 3) > 0.9 && ratio(0) < 0.1:
+		return "utf-32le", ratio(2, 3)
+	case ratio(0, 1) > 0.9 && ratio(3) < 0.1:
+		return "utf-32be", ratio(0, 1)
+	case ratio(1, 3) > 0.5 && ratio(0, 2) < 0.1:
+		return "utf-16le", ratio(1, 3)
+	case ratio(0, 2) > 0.5 && ratio(1, 3) < 0.1:
+		return "utf-16be", ratio(0, 2)
+	}
+	return "", 0
+}
+
+// detect_iso2022 finds the designations of ISO-2022 encodings in 7-bit text.
+func detect_iso2022(p []byte) string {
+	if slices.ContainsFunc(p, func(ch byte) bool { return ch >= 0x80 }) {
+		return ""
+	}
+	switch {
+	case bytes.Contains(p, []byte("\x1b$)C")):
+		return "iso-2022-kr"
+	case bytes.Contains(p, []byte("\x1b$)A")), bytes.Contains(p, []byte("\x1b$)G")):
+		return "iso-2022-cn"
+	case bytes.Contains(p, []byte("\x1b$B")), bytes.Contains(p, []byte("\x1b$@")),
+		bytes.Contains(p, []byte("\x1b(I")):
+		return "iso-2022-jp"
+	}
+	return ""
+}
+
+// DetectEncoding guesses the encoding of p and returns its name and a
+// confidence between 0 and 1.
+func DetectEncoding(p []byte) (string, float64) {
+	switch {
+	case bytes.HasPrefix(p, []byte{0xff, 0xfe, 0x00, 0x00}):
+		return "utf-32le", 1.0
+	case bytes.HasPrefix(p, []byte{0x00, 0x00, 0xfe, 0xff}):
+		return "utf-32be", 1.0
+	case bytes.HasPrefix(p, []byte{0xff, 0xfe}):
+		return "utf-16le", 1.0
+	case bytes.HasPrefix(p, []byte{0xfe, 0xff}):
+		return "utf-16be", 1.0
+	case bytes.HasPrefix(p, []byte{0xef, 0xbb, 0xbf}):
+		return "utf-8", 1.0
+	}
+	if name, confidence := detect_utf16_32(p); name != "" {
+		return name, min(1, confidence)
+	}
+	if name := detect_iso2022(p); name != "" {
+		return name, 1.0
+	}
+	best, best_score := "utf-8", 0.0
+	score, count := score_utf8(p)
+	if count == 0 {
+		// ascii only
+		return best, 1.0
+	}
+	best_score = score
+	slog.Debug("encoding score", "encoding", best, "score", score)
+	for _, c := range detect_candidates {
+		score := score_candidate(c, p)
+		slog.Debug("encoding score", "encoding", c.name, "score", score)
+		if score > best_score {
+			best, best_score = c.name, score
+		}
+	}
+	if len(p)%2 == 0 {
+		for _, name := range []string{"utf-16be", "utf-16le"} {
+			score := score_utf16(p, name == "utf-16le")
+			slog.Debug("encoding score", "encoding", name, "score", score)
+			if score > best_score {
+				best, best_score = name, score
+			}
+		}
+	}
+	return best, max(0, min(1, best_score))
+}
+
+// HasAuto tells if a printable column has the "auto" encoding.
+func (l *Layout) HasAuto() bool {
+	return slices.ContainsFunc(l.columns, func(col column) bool { return col.encoding == "auto" })
+}
+
+// ResolveAuto replaces the "auto" encoding of printable columns with the one
+// detected from sample.
+func (l *Layout) ResolveAuto(sample []byte) {
+	if !l.HasAuto() {
+		return
+	}
+	detected, confidence := DetectEncoding(sample)
+	slog.Debug("detected encoding", "encoding", detected, "confidence", confidence)
+	for idx := range l.columns {
+		if l.columns[idx].encoding == "auto" {
+			l.columns[idx].encoding = detected
+		}
+	}
+}
@@ -0,0 +1,50 @@
+package uhd
+
+import (
+	"bytes"
+	"testing"
+)
+
+//nolint:gosmopolitan
+func TestDetectEncoding(t *testing.T) {
+	tests := []struct {
+		expected string
+		input    []byte
+	}{
+		{"utf-8", []byte("hello world")},
+		{"utf-8", []byte("こんにちは、世界。")},
+		{"utf-8", []byte{0xef, 0xbb, 0xbf, 0x61}},
+		{"utf-16le", []byte{0xff, 0xfe, 0x61, 0x00}},
+		{"utf-16be", []byte{0xfe, 0xff, 0x00, 0x61}},
+		{"utf-32le", []byte{0xff, 0xfe, 0x00, 0x00, 0x61, 0x00, 0x00, 0x00}},
+		{"utf-32be", []byte{0x00, 0x00, 0xfe, 0xff, 0x00, 0x00, 0x00, 0x61}},
+		{"utf-16le", []byte{0x68, 0x00, 0x65, 0x00, 0x6c, 0x00, 0x6c, 0x00, 0x6f, 0x00}},
+		{"utf-16le", []byte{0x53, 0x30, 0x93, 0x30, 0x6b, 0x30, 0x61, 0x30, 0x6f, 0x30}},
+		{"shift-jis", []byte{0x82, 0xb1, 0x82, 0xf1, 0x82, 0xc9, 0x82, 0xbf, 0x82, 0xcd, 0x81, 0x41,
+			0x90, 0xa2, 0x8a, 0x45, 0x81, 0x42}},
+		// kana only, which also decode as the extended hangul of cp949
+		{"shift-jis", bytes.Repeat([]byte{0x82, 0xb1, 0x82, 0xf1, 0x82, 0xc9, 0x82, 0xbf, 0x82, 0xcd, 0x90, 0xa2}, 3)},
+		{"cp932", []byte{0x87, 0x40, 0x82, 0xb1, 0x82, 0xf1, 0x82, 0xc9, 0x82, 0xbf, 0x82, 0xcd, 0x87, 0x41}},
+		{"euc-jp", []byte{0xa4, 0xb3, 0xa4, 0xf3, 0xa4, 0xcb, 0xa4, 0xc1, 0xa4, 0xcf, 0xa1, 0xa2,
+			0xc0, 0xa4, 0xb3, 0xa6, 0xa1, 0xa3}},
+		{"euc-kr", []byte{0xbe, 0xc8, 0xb3, 0xe7, 0xc7, 0xcf, 0xbc, 0xbc, 0xbf, 0xe4, 0x20, 0xbc,
+			0xbc, 0xb0, 0xe8}},
+		{"cp949", []byte{0xbe, 0xc8, 0xb3, 0xe7, 0x81, 0x41, 0xc7, 0xcf, 0xbc, 0xbc, 0xbf, 0xe4}},
+		{"gb18030", []byte{0xc4, 0xe3, 0xba, 0xc3, 0xca, 0xc0, 0xbd, 0xe7, 0xbd, 0xf1, 0xcc, 0xec,
+			0xcc, 0xec, 0xc6, 0xf8, 0xba, 0xdc, 0xba, 0xc3}},
+		{"big5", []byte{0xa7, 0x41, 0xa6, 0x6e, 0xa5, 0x40, 0xac, 0xc9, 0xa4, 0xb5, 0xa4, 0xd1,
+			0xa4, 0xd1, 0xae, 0xf0, 0xab, 0xdc, 0xa6, 0x6e}},
+		{"iso-2022-jp", []byte("\x1b$B$3$s$K$A$O\x1b(B world")},
+		{"iso-2022-kr", []byte("\x1b$)C\x0e>H3g\x0f")},
+		{"iso-2022-cn", []byte("\x1b$)A\x0eDc:C\x0f")},
+	}
+	for _, tt := range tests {
+		got, confidence := DetectEncoding(tt.input)
+		if got != tt.expected {
+			t.Errorf("detect %x: got %s (%f), want %s", tt.input, got, confidence, tt.expected)
+		}
+		if confidence < 0 || confidence > 1 {
+			t.Errorf("detect %x: confidence out of range: %f", tt.input, confidence)
+		}
+	}
+}
@@ -0,0 +1,249 @@
+// Package uhd renders encoding-aware hex dumps: hex columns side by side with
+// the text decoded in one or more encodings, as text, JSON or HTML.
+package uhd
+
+import (
+	"fmt"
+	"io"
+	"log/slog"
+	"sync"
+
+	"github.com/acomagu/bufpipe"
+)
+
+// Options configures a Dumper. The zero value dumps like the uhd command
+// without options.
+type Options struct {
+	// Layout is "jhd", "hexdump", "bytes" or a column list, default "jhd".
+	Layout string
+	// Encoding is the encoding of the printable columns, default "utf-8".
+	// A comma-separated list gives one printable column each, and "auto"
+	// detects the encoding from the first DetectSampleSize bytes.
+	Encoding string
+	// Width is the number of bytes in a row, default 16.
+	Width int
+	// Sep puts an extra space every Sep bytes in hex columns, default 8. It
+	// is also the word size of the uint, int and float columns.
+	Sep int
+	// Offset is the offset of the first byte written. Rows stay aligned to
+	// Width.
+	Offset uint64
+	// Format is "text", "json", "jsonl", "html" or the language of an array
+	// declaration like xxd -i: "c", "go", "rust", "python" or "js". Default
+	// "text".
+	Format string
+	// Title is the title of an html page, and the file name that the array
+	// of a declaration is named after.
+	Title string
+	// Components shows the code points of UTF-8 grapheme clusters one by
+	// one instead of a cluster across its bytes.
+	Components bool
+	// ControlStyle shows the C0 controls and DEL as "dots" (default),
+	// "pictures" (␀ ␊), "caret" (^@ ^J) or "names" (NUL LF).
+	ControlStyle string
+	// AddressFormat is the radix of the header columns: "hex" (default),
+	// "dec", "oct" or "none".
+	AddressFormat string
+	// BaseAddress is added to the addresses shown.
+	BaseAddress uint64
+	// OtherRadix adds a header column with the address in the other radix.
+	OtherRadix bool
+	// Size is the number of bytes that will be written, if known. The header
+	// columns are widened to fit the last address.
+	Size uint64
+	// NoSqueeze shows every row of the text output. By default a run of rows
+	// with the same bytes is squeezed into "*".
+	NoSqueeze bool
+	// SqueezeCount writes "* (N rows, 0xNNNN bytes of XX)" instead of "*".
+	SqueezeCount bool
+}
+
+func (o Options) withDefaults() Options {
+	if o.Layout == "" {
+		o.Layout = "jhd"
+	}
+	if o.Encoding == "" {
+		o.Encoding = "utf-8"
+	}
+	if o.Width == 0 {
+		o.Width = 16
+	}
+	if o.Sep == 0 {
+		o.Sep = 8
+	}
+	if o.Format == "" {
+		o.Format = "text"
+	}
+	return o
+}
+
+// textwriter renders the text output: each column writer feeds a pipe and the
+// paster joins their lines into rows.
+type textwriter struct {
+	writers []io.Writer
+	wr      io.Writer
+	wg      *sync.WaitGroup
+	err     error
+}
+
+func (t *textwriter) Write(p []byte) (n int, err error) {
+	return t.wr.Write(p)
+}
+
+func (t *textwriter) Close() error {
+	for _, w := range t.writers {
+		if closer, ok := w.(io.Closer); ok {
+			if err := closer.Close(); err != nil {
+				slog.Error("close writer", "err", err)
+			}
+		}
+	}
+	t.wg.Wait()
+	return t.err
+}
+
+func newTextWriter(output io.Writer, layout *Layout, opts Options) *textwriter {
+	t := &textwriter{wg: &sync.WaitGroup{}}
+	widths := make([]int, 0, len(layout.columns))
+	readers := make([]io.Reader, 0, len(layout.columns))
+	for _, col := range layout.columns {
+		r, w := bufpipe.New(nil)
+		t.writers = append(t.writers, layout.new_writer(col, w))
+		if ofs, ok := t.writers[len(t.writers)-1].(offsetter); ok && opts.Offset != 0 {
+			ofs.SetOffset(opts.Offset)
+		}
+		readers = append(readers, r)
+		widths = append(widths, col.width)
+	}
+	var keys io.Reader
+	if !opts.NoSqueeze {
+		// repeated rows are found from their bytes, whatever the columns show
+		r, w := bufpipe.New(nil)
+		rk := &rowkeys{output: w, width: layout.width}
+		if opts.Offset != 0 {
+			rk.SetOffset(opts.Offset)
+		}
+		t.writers = append(t.writers, rk)
+		keys = r
+	}
+	t.wr = io.MultiWriter(t.writers...)
+	pst := newPaster(output, keys, opts.SqueezeCount, readers...)
+	t.wg.Go(func() {
+		slog.Debug("widths", "values", widths)
+		if err := pst.Process(widths...); err != nil {
+			slog.Error("paster", "err", err)
+			t.err = err
+		}
+	})
+	return t
+}
+
+// Dumper renders the bytes written to it. Close must be called to flush the
+// last row.
+type Dumper struct {
+	output io.Writer
+	opts   Options
+	layout *Layout
+	sample []byte
+	wr     io.WriteCloser
+	err    error
+}
+
+// start resolves the "auto" encoding and creates the writer of the format.
+func (d *Dumper) start() error {
+	d.layout.ResolveAuto(d.sample)
+	switch d.opts.Format {
+	case "text":
+		d.wr = newTextWriter(d.output, d.layout, d.opts)
+	case "json", "jsonl":
+		jw := newJSONWriter(d.output, d.layout, d.opts.Format == "jsonl")
+		if d.opts.Offset != 0 {
+			jw.SetOffset(d.opts.Offset)
+		}
+		d.wr = jw
+	case "html":
+		hw := newHTMLWriter(d.output, d.layout, d.opts.Title)
+		if d.opts.Offset != 0 {
+			hw.SetOffset(d.opts.Offset)
+		}
+		d.wr = hw
+	default:
+		sw, err := newSourceWriter(d.output, d.layout, d.opts.Format, d.opts.Title)
+		if err != nil {
+			return err
+		}
+		if d.opts.Offset != 0 {
+			sw.SetOffset(d.opts.Offset)
+		}
+		d.wr = sw
+	}
+	sample := d.sample
+	d.sample = nil
+	_, err := d.wr.Write(sample)
+	return err
+}
+
+func (d *Dumper) Write(p []byte) (n int, err error) {
+	if d.err != nil {
+		return 0, d.err
+	}
+	if d.wr == nil {
+		if d.layout.HasAuto() && len(d.sample)+len(p) < DetectSampleSize {
+			d.sample = append(d.sample, p...)
+			return len(p), nil
+		}
+		d.sample = append(d.sample, p...)
+		if err := d.start(); err != nil {
+			d.err = err
+			return 0, err
+		}
+		return len(p), nil
+	}
+	return d.wr.Write(p)
+}
+
+func (d *Dumper) Close() error {
+	if d.err != nil {
+		return d.err
+	}
+	if d.wr == nil {
+		if err := d.start(); err != nil {
+			return err
+		}
+	}
+	return d.wr.Close()
+}
+
+// NewDumper returns a writer that dumps the bytes written to it into w, like
+// encoding/hex.Dumper. An invalid option is returned as the error of Write
+// and Close.
+func NewDumper(w io.Writer, opts Options) io.WriteCloser {
+	opts = opts.withDefaults()
+	d := &Dumper{output: w, opts: opts}
+	switch opts.Format {
+	case "text", "json", "jsonl", "html", "c", "go", "rust", "python", "js":
+	default:
+		d.err = fmt.Errorf("unknown format: %q", opts.Format)
+		return d
+	}
+	d.layout, d.err = ParseLayout(opts.Layout, opts.Encoding, opts.Width, opts.Sep)
+	if d.err == nil && opts.Components {
+		d.layout.ShowComponents()
+	}
+	if d.err == nil && opts.ControlStyle != "" {
+		d.err = d.layout.SetControlStyle(opts.ControlStyle)
+	}
+	if d.err == nil && opts.AddressFormat != "" {
+		d.err = d.layout.SetAddressFormat(opts.AddressFormat)
+	}
+	if d.err == nil {
+		if opts.OtherRadix {
+			d.layout.AddOtherRadix()
+		}
+		d.layout.SetBaseAddress(opts.BaseAddress)
+		if opts.Size != 0 {
+			d.layout.SetAddressLimit(opts.Offset + opts.Size)
+		}
+	}
+	return d
+}
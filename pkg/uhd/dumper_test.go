@@ -0,0 +1,180 @@
+package uhd
+
+import (
+	"bytes"
+	"os"
+	"strings"
+	"testing"
+)
+
+func TestDumper(t *testing.T) {
+	buf := &bytes.Buffer{}
+	d := NewDumper(buf, Options{Layout: "hexdump", Width: 8, Offset: 5})
+	for _, p := range []string{"hel", "lo world"} {
+		if _, err := d.Write([]byte(p)); err != nil {
+			t.Fatal("write", "err", err)
+		}
+	}
+	if err := d.Close(); err != nil {
+		t.Fatal("close", "err", err)
+	}
+	expected := "00000000                 68 65 6c   |     hel|\n" +
+		"00000008  6c 6f 20 77 6f 72 6c 64   |lo world|\n"
+	if buf.String() != expected {
+		t.Errorf("unexpected output:\ngot:  %q\nwant: %q", buf.String(), expected)
+	}
+}
+
+//nolint:gosmopolitan
+func TestDumper_Auto(t *testing.T) {
+	buf := &bytes.Buffer{}
+	d := NewDumper(buf, Options{Layout: "printable", Encoding: "auto", Format: "jsonl"})
+	// "こんにちは" in shift-jis, written one byte at a time
+	for _, ch := range []byte("\x82\xb1\x82\xf1\x82\xc9\x82\xbf\x82\xcd") {
+		if _, err := d.Write([]byte{ch}); err != nil {
+			t.Fatal("write", "err", err)
+		}
+	}
+	if err := d.Close(); err != nil {
+		t.Fatal("close", "err", err)
+	}
+	if !strings.Contains(buf.String(), `"encoding":"shift-jis"`) || !strings.Contains(buf.String(), `"text":"こ"`) {
+		t.Errorf("unexpected output: %q", buf.String())
+	}
+}
+
+func TestDumper_Components(t *testing.T) {
+	for _, tt := range []struct {
+		components bool
+		expected   string
+	}{
+		{false, "e\u0301__a           \n"},
+		{true, "e◌\u0301_a           \n"},
+	} {
+		buf := &bytes.Buffer{}
+		d := NewDumper(buf, Options{Layout: "printable", Components: tt.components})
+		if _, err := d.Write([]byte("e\u0301a")); err != nil {
+			t.Fatal("write", "err", err)
+		}
+		if err := d.Close(); err != nil {
+			t.Fatal("close", "err", err)
+		}
+		if buf.String() != tt.expected {
+			t.Errorf("unexpected output:\ngot:  %q\nwant: %q", buf.String(), tt.expected)
+		}
+	}
+}
+
+func TestDumper_ControlStyle(t *testing.T) {
+	buf := &bytes.Buffer{}
+	d := NewDumper(buf, Options{Layout: "printable,hexbytes", ControlStyle: "caret"})
+	if _, err := d.Write([]byte("a\tb\n")); err != nil {
+		t.Fatal("write", "err", err)
+	}
+	if err := d.Close(); err != nil {
+		t.Fatal("close", "err", err)
+	}
+	expected := "a^Ib^J" + strings.Repeat(" ", 27) + "0x61, 0x09, 0x62, 0x0A," + strings.Repeat(" ", 74) + "\n"
+	if buf.String() != expected {
+		t.Errorf("unexpected output:\ngot:  %q\nwant: %q", buf.String(), expected)
+	}
+}
+
+func TestDumper_SqueezeOctal(t *testing.T) {
+	buf := &bytes.Buffer{}
+	d := NewDumper(buf, Options{Layout: "header,octal", Width: 4})
+	if _, err := d.Write(make([]byte, 16)); err != nil {
+		t.Fatal("write", "err", err)
+	}
+	if err := d.Close(); err != nil {
+		t.Fatal("close", "err", err)
+	}
+	row := " 000 000 000 000 "
+	expected := "00000000 " + row + "\n*\n0000000C " + row + "\n"
+	if buf.String() != expected {
+		t.Errorf("unexpected output:\ngot:  %q\nwant: %q", buf.String(), expected)
+	}
+}
+
+func TestDumper_Address(t *testing.T) {
+	buf := &bytes.Buffer{}
+	d := NewDumper(buf, Options{Layout: "header,hexbytes", Width: 2, AddressFormat: "dec", OtherRadix: true, BaseAddress: 0x08000000, Offset: 1, Size: 3})
+	if _, err := d.Write([]byte("abc")); err != nil {
+		t.Fatal("write", "err", err)
+	}
+	if err := d.Close(); err != nil {
+		t.Fatal("close", "err", err)
+	}
+	expected := "134217728 08000000       0x61,  \n134217730 08000002 0x62, 0x63,  \n"
+	if buf.String() != expected {
+		t.Errorf("unexpected output:\ngot:  %q\nwant: %q", buf.String(), expected)
+	}
+}
+
+func TestDumper_Squeeze(t *testing.T) {
+	data := append(make([]byte, 20), "abcdabcdabcdab"...)
+	tests := []struct {
+		opts     Options
+		expected string
+	}{
+		// the bytes layout has no hex column, and still squeezes
+		{Options{Layout: "header,hexbytes"}, "00000000 0x00, 0x00, 0x00, 0x00,  \n*\n" +
+			"00000014 0x61, 0x62, 0x63, 0x64,  \n*\n00000020 0x61, 0x62,              \n"},
+		{Options{Layout: "header,hexbytes", SqueezeCount: true}, "00000000 0x00, 0x00, 0x00, 0x00,  \n* (4 rows, 0x10 bytes of 00)\n" +
+			"00000014 0x61, 0x62, 0x63, 0x64,  \n* (2 rows, 0x8 bytes)\n00000020 0x61, 0x62,              \n"},
+		{Options{Layout: "header,hexbytes", Offset: 2, NoSqueeze: true}, "00000000             0x00, 0x00,  \n00000004 0x00, 0x00, 0x00, 0x00,  \n" +
+			"00000008 0x00, 0x00, 0x00, 0x00,  \n0000000C 0x00, 0x00, 0x00, 0x00,  \n00000010 0x00, 0x00, 0x00, 0x00,  \n" +
+			"00000014 0x00, 0x00, 0x61, 0x62,  \n00000018 0x63, 0x64, 0x61, 0x62,  \n0000001C 0x63, 0x64, 0x61, 0x62,  \n" +
+			"00000020 0x63, 0x64, 0x61, 0x62,  \n"},
+	}
+	for _, tt := range tests {
+		tt.opts.Width = 4
+		buf := &bytes.Buffer{}
+		d := NewDumper(buf, tt.opts)
+		if _, err := d.Write(data); err != nil {
+			t.Fatal("write", "err", err)
+		}
+		if err := d.Close(); err != nil {
+			t.Fatal("close", "err", err)
+		}
+		if buf.String() != tt.expected {
+			t.Errorf("unexpected output:\ngot:  %q\nwant: %q", buf.String(), tt.expected)
+		}
+	}
+}
+
+func TestDumper_LongCluster(t *testing.T) {
+	// a cluster of 600 KB would not fit in a line of the paster
+	data := append([]byte("a"), bytes.Repeat([]byte("\u0301"), 300000)...)
+	buf := &bytes.Buffer{}
+	d := NewDumper(buf, Options{NoSqueeze: true})
+	if _, err := d.Write(data); err != nil {
+		t.Fatal("write", "err", err)
+	}
+	if err := d.Close(); err != nil {
+		t.Fatal("close", "err", err)
+	}
+	if rows := strings.Count(buf.String(), "\n"); rows != (len(data)+15)/16 {
+		t.Errorf("unexpected rows: %d", rows)
+	}
+}
+
+func TestDumper_Invalid(t *testing.T) {
+	for _, opts := range []Options{{Layout: "unknown"}, {Encoding: "no-such-encoding"}, {Format: "xml"}, {Sep: -1}, {ControlStyle: "hex"}, {AddressFormat: "bin"}} {
+		d := NewDumper(&bytes.Buffer{}, opts)
+		if _, err := d.Write([]byte("a")); err == nil {
+			t.Error("no error from Write", "opts", opts)
+		}
+		if err := d.Close(); err == nil {
+			t.Error("no error from Close", "opts", opts)
+		}
+	}
+}
+
+func ExampleNewDumper() {
+	d := NewDumper(os.Stdout, Options{Layout: "hexdump"})
+	d.Write([]byte("hello, world\n"))
+	d.Close()
+	// Output:
+	// 00000000  68 65 6c 6c 6f 2c 20 77  6f 72 6c 64 0a             |hello, world.
+}
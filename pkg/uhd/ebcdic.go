@@ -0,0 +1,165 @@
+package uhd
+
+import (
+	"bytes"
+	"strings"
+	"sync"
+	"unicode"
+
+	"golang.org/x/text/encoding/charmap"
+)
+
+// ebcdic_codepage is a mixed host code page: the single-byte set used out of
+// SO/SI and the double-byte sets used between them, looked up in order.
+type ebcdic_codepage struct {
+	sbcs string
+	dbcs []string
+}
+
+// ebcdic_codepages maps the names of the host code pages to their sets.
+var ebcdic_codepages = map[string]ebcdic_codepage{
+	"ibm930":  {"930", []string{"300"}},
+	"ibm939":  {"939", []string{"300"}},
+	"ibm1390": {"1390", []string{"16684", "300"}},
+	"ibm1399": {"1399", []string{"16684", "300"}},
+	"ibm933":  {"933", []string{"834"}},
+	"ibm935":  {"935", []string{"837"}},
+	"ibm937":  {"937", []string{"835"}},
+}
+
+// ebcdic_lookup returns the code page of an EBCDIC encoding name such as
+// "ibm930", "cp930" or "ibm-930".
+func ebcdic_lookup(name string) (ebcdic_codepage, bool) {
+	name = strings.ToLower(name)
+	for _, prefix := range []string{"ibm-", "cp"} {
+		if rest, ok := strings.CutPrefix(name, prefix); ok {
+			name = "ibm" + rest
+			break
+		}
+	}
+	cp, ok := ebcdic_codepages[name]
+	return cp, ok
+}
+
+type ebcdic_runes struct {
+	sbcs map[string][]rune
+	dbcs map[string]map[byte][]rune
+}
+
+// ebcdic_table converts the strings of the generated tables to runes once.
+var ebcdic_table = sync.OnceValue(func() ebcdic_runes {
+	res := ebcdic_runes{
+		sbcs: map[string][]rune{},
+		dbcs: map[string]map[byte][]rune{},
+	}
+	for name, s := range ebcdic_sbcs {
+		res.sbcs[name] = []rune(s)
+	}
+	for name, rows := range ebcdic_dbcs {
+		res.dbcs[name] = map[byte][]rune{}
+		for lead, s := range rows {
+			res.dbcs[name][lead] = []rune(s)
+		}
+	}
+	return res
+})
+
+// decode returns the character of the single byte or the double-byte pair b,
+// or "" if it is not a valid or printable character.
+func (cp ebcdic_codepage) decode(b []byte) string {
+	tbl := ebcdic_table()
+	if len(b) == 1 {
+		if r := tbl.sbcs[cp.sbcs][b[0]]; unicode.IsPrint(r) && r != unicode.ReplacementChar {
+			return string(r)
+		}
+		return ""
+	}
+	if b[1] < 0x40 || b[1] == 0xff {
+		return ""
+	}
+	for _, set := range cp.dbcs {
+		if set == "16684" {
+			if s, ok := ebcdic_pairs[uint16(b[0])<<8|uint16(b[1])]; ok {
+				return s
+			}
+		}
+		row := tbl.dbcs[set][b[0]]
+		if row == nil {
+			continue
+		}
+		if r := row[b[1]-0x40]; r != unicode.ReplacementChar {
+			if unicode.IsPrint(r) {
+				return string(r)
+			}
+			return ""
+		}
+	}
+	return ""
+}
+
+// ebcdic_resync returns the position of the last SO or SI in p, or 0 if
+// there is none.
+func ebcdic_resync(p []byte) int {
+	return max(0, bytes.LastIndexByte(p, 0x0e), bytes.LastIndexByte(p, 0x0f))
+}
+
+// ebcdic is the state of a host code page decoder: SO shifts to the
+// double-byte sets and SI back to the single-byte set.
+type ebcdic struct {
+	cp    ebcdic_codepage
+	shift bool
+}
+
+// ebcdic_next shows the shift or character at the top of p and returns its
+// length, or 0 if p ends in the middle of a double-byte character.
+func (h *Printable) ebcdic_next(p []byte) int {
+	st := h.host
+	size := 1
+	if st.shift && p[0] >= 0x40 && p[0] != 0xff {
+		if len(p) < 2 {
+			return 0
+		}
+		if p[1] >= 0x40 {
+			size = 2
+		}
+	}
+	if h.cur%uint64(h.width) == 0 {
+		h.text(h.start_ch)
+	}
+	switch p[0] {
+	case 0x0e:
+		st.shift = true
+		h.marker("{")
+	case 0x0f:
+		st.shift = false
+		h.marker("}")
+	default:
+		s := ""
+		if !st.shift || size == 2 {
+			s = st.cp.decode(p[:size])
+		}
+		if s == "" && size == 1 {
+			// the controls are the same in all EBCDIC code pages
+			h.put_control(charmap.CodePage037.DecodeByte(p[0]), 1)
+		} else {
+			h.put(s, size)
+		}
+	}
+	return size
+}
+
+func (h *Printable) writeEBCDIC(p []byte, cp ebcdic_codepage) (n int, err error) {
+	if h.host == nil {
+		h.host = &ebcdic{cp: cp}
+	}
+	buf := append(h.rest, p...)
+	for len(buf) > 0 {
+		size := h.ebcdic_next(buf)
+		if size == 0 {
+			break
+		}
+		buf = buf[size:]
+	}
+	h.rest = bytes.Clone(buf)
+	return len(p), nil
+}
@@ -0,0 +1,84 @@
+package uhd
+
+import (
+	"bufio"
+	"os"
+	"strconv"
+	"strings"
+	"testing"
+	"unicode"
+)
+
+//nolint:gosmopolitan
+func TestEBCDICDecode(t *testing.T) {
+	// code points of the IBM host code pages of glibc iconv
+	tests := []struct {
+		name     string
+		input    []byte
+		expected string
+	}{
+		{"ibm930", []byte{0xc1}, "A"},
+		{"ibm930", []byte{0x81}, "ｱ"},
+		{"ibm939", []byte{0x81}, "a"},
+		{"ibm930", []byte{0x45, 0x41}, "一"},
+		{"ibm1390", []byte{0xb3, 0x8d}, "\U00020b9f"},
+		{"ibm1390", []byte{0xec, 0xb5}, "か\u309a"},
+		{"ibm933", []byte{0x88, 0x61}, "가"},
+		{"ibm935", []byte{0x5b, 0xcf}, "中"},
+		{"ibm937", []byte{0x4c, 0x84}, "中"},
+		{"ibm930", []byte{0x40, 0x40}, ""},
+		{"ibm930", []byte{0x15}, ""},
+	}
+	for _, tt := range tests {
+		cp, _ := ebcdic_lookup(tt.name)
+		if got := cp.decode(tt.input); got != tt.expected {
+			t.Errorf("unexpected output of %s % x:\ngot:  %q\nwant: %q", tt.name, tt.input, got, tt.expected)
+		}
+	}
+}
+
+func TestEBCDICDecode_mapping(t *testing.T) {
+	// every code of the mappings the tables are generated from
+	for _, name := range []string{"ibm930", "ibm939", "ibm1390", "ibm1399", "ibm933", "ibm935", "ibm937"} {
+		cp, ok := ebcdic_lookup(name)
+		if !ok {
+			t.Fatal("lookup", "name", name)
+		}
+		fp, err := os.Open("testdata/" + name + ".txt")
+		if err != nil {
+			t.Fatal("open", "err", err)
+		}
+		defer fp.Close()
+		scanner := bufio.NewScanner(fp)
+		for scanner.Scan() {
+			fields := strings.Fields(scanner.Text())
+			if len(fields) != 2 || strings.HasPrefix(fields[0], "#") {
+				continue
+			}
+			code, err := strconv.ParseUint(strings.TrimPrefix(fields[0], "0x"), 16, 32)
+			if err != nil {
+				t.Fatal("code", "err", err)
+			}
+			input := []byte{byte(code)}
+			if code > 0xff {
+				input = []byte{byte(code >> 8), byte(code)}
+			}
+			var sb strings.Builder
+			for _, cp := range strings.Split(strings.TrimPrefix(fields[1], "U+"), "+") {
+				r, _ := strconv.ParseUint(cp, 16, 32)
+				sb.WriteRune(rune(r))
+			}
+			expected := sb.String()
+			if strings.IndexFunc(expected, func(r rune) bool { return !unicode.IsPrint(r) }) >= 0 {
+				// controls, U+3000 and user-defined cells are shown as unprintable
+				expected = ""
+			}
+			if got := cp.decode(input); got != expected {
+				t.Errorf("unexpected output of %s %s:\ngot:  %q\nwant: %q", name, fields[0], got, expected)
+			}
+		}
+		if err := scanner.Err(); err != nil {
+			t.Fatal("scan", "err", err)
+		}
+	}
+}
@@ -0,0 +1,119 @@
+package uhd
+
+import (
+	"fmt"
+	"strings"
+	"sync"
+	"unicode/utf8"
+)
+
+// eucjis2004_codes and sjis2004_codes map the characters of the JIS X 0213
+// decoders back to their bytes. They are built on first use.
+var (
+	eucjis2004_codes = sync.OnceValue(func() map[string][]byte {
+		codes := [][]byte{}
+		for b1 := 0xa1; b1 <= 0xfe; b1++ {
+			for b2 := 0xa1; b2 <= 0xfe; b2++ {
+				codes = append(codes, []byte{byte(b1), byte(b2)})
+			}
+		}
+		for b := 0xa1; b <= 0xdf; b++ {
+			codes = append(codes, []byte{0x8e, byte(b)})
+		}
+		for b1 := 0xa1; b1 <= 0xfe; b1++ {
+			for b2 := 0xa1; b2 <= 0xfe; b2++ {
+				codes = append(codes, []byte{0x8f, byte(b1), byte(b2)})
+			}
+		}
+		return decoder_inverse(codes, decode_eucjis2004)
+	})
+	sjis2004_codes = sync.OnceValue(func() map[string][]byte {
+		codes := [][]byte{}
+		for b1 := 0x81; b1 <= 0xfc; b1++ {
+			if in_range(byte(b1), 0xa0, 0xdf) {
+				continue
+			}
+			for b2 := 0x40; b2 <= 0xfc; b2++ {
+				if b2 != 0x7f {
+					codes = append(codes, []byte{byte(b1), byte(b2)})
+				}
+			}
+		}
+		for b := 0xa1; b <= 0xdf; b++ {
+			codes = append(codes, []byte{byte(b)})
+		}
+		return decoder_inverse(codes, decode_sjis2004)
+	})
+)
+
+// decoder_inverse maps what decode shows for each of codes back to the code.
+// The first code of a character is taken.
+func decoder_inverse(codes [][]byte, decode func(b []byte) string) map[string][]byte {
+	res := map[string][]byte{}
+	for _, code := range codes {
+		s := decode(code)
+		if s == "" || strings.ContainsRune(s, utf8.RuneError) {
+			continue
+		}
+		if _, ok := res[s]; !ok {
+			res[s] = code
+		}
+	}
+	return res
+}
+
+// encode_table encodes text with the codes of a decoder_inverse table. ASCII
+// is kept, and a base and a combining character that have a code of their
+// own are taken together.
+func encode_table(text string, table map[string][]byte) ([]byte, error) {
+	res := []byte{}
+	runes := []rune(text)
+	for i := 0; i < len(runes); i++ {
+		if runes[i] < 0x80 {
+			res = append(res, byte(runes[i]))
+			continue
+		}
+		if i+1 < len(runes) {
+			if code, ok := table[string(runes[i:i+2])]; ok {
+				res = append(res, code...)
+				i++
+				continue
+			}
+		}
+		code, ok := table[string(runes[i])]
+		if !ok {
+			return nil, fmt.Errorf("no code for %q", runes[i])
+		}
+		res = append(res, code...)
+	}
+	return res, nil
+}
+
+// EncodeText converts text into the bytes of the named encoding, to search
+// for it. It covers the encodings of LookupEncoding, the UTF-8 variants and
+// the JIS X 0213 encodings. The stateful encodings (ISO-2022, UTF-7 and the
+// EBCDIC code pages with SO/SI) are refused, as the bytes of text depend on
+// the shift state before it.
+func EncodeText(text, name string) ([]byte, error) {
+	if enc := LookupEncoding(name); enc != nil {
+		return enc.NewEncoder().Bytes([]byte(text))
+	}
+	if variant := utf8_variant(name); variant != "" {
+		return encode_utf8_variant(text, variant), nil
+	}
+	switch strings.ToLower(name) {
+	case "euc-jis-2004", "euc-jisx0213", "eucjis2004":
+		return encode_table(text, eucjis2004_codes())
+	case "shift_jis-2004", "shift-jis-2004", "sjis-2004", "shift_jisx0213":
+		return encode_table(text, sjis2004_codes())
+	case "utf-7", "utf7":
+		return nil, fmt.Errorf("%s is stateful: the bytes of text depend on the shift state before it", name)
+	}
+	if iso2022_variant(name) != "" {
+		return nil, fmt.Errorf("%s is stateful: the bytes of text depend on the shift state before it", name)
+	}
+	if _, ok := ebcdic_lookup(name); ok {
+		return nil, fmt.Errorf("%s is stateful: the bytes of text depend on the shift state before it", name)
+	}
+	return nil, fmt.Errorf("unknown encoding: %q", name)
+}
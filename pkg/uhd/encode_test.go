@@ -0,0 +1,60 @@
+package uhd
+
+import (
+	"bytes"
+	"testing"
+)
+
+//nolint:gosmopolitan
+func TestEncodeText(t *testing.T) {
+	tests := []struct {
+		text     string
+		encoding string
+		expected []byte
+	}{
+		{"aあ", "utf-8", []byte("aあ")},
+		{"あ", "shift-jis", []byte{0x82, 0xa0}},
+		{"a\U0001F600", "cesu-8", []byte{0x61, 0xed, 0xa0, 0xbd, 0xed, 0xb8, 0x80}},
+		{"a\x00\U0001F600", "mutf-8", []byte{0x61, 0xc0, 0x80, 0xed, 0xa0, 0xbd, 0xed, 0xb8, 0x80}},
+		{"\U0001F600", "wtf-8", []byte{0xf0, 0x9f, 0x98, 0x80}},
+		// JIS X 0208, a character added by JIS X 0213 and a pair of a base
+		// and a combining character
+		{"aあ⊄か\u309a", "euc-jis-2004", []byte{0x61, 0xa4, 0xa2, 0xa2, 0xc2, 0xa4, 0xf7}},
+		{"aあ⊄か\u309aｱ", "shift_jis-2004", []byte{0x61, 0x82, 0xa0, 0x81, 0xc0, 0x82, 0xf5, 0xb1}},
+	}
+	for _, tt := range tests {
+		got, err := EncodeText(tt.text, tt.encoding)
+		if err != nil {
+			t.Errorf("%s: %v", tt.encoding, err)
+		} else if !bytes.Equal(got, tt.expected) {
+			t.Errorf("unexpected output of %s:\ngot:  % x\nwant: % x", tt.encoding, got, tt.expected)
+		}
+	}
+	for _, name := range []string{"iso-2022-jp", "utf-7", "ibm930", "unknown"} {
+		if _, err := EncodeText("a", name); err == nil {
+			t.Errorf("%s: expected error", name)
+		}
+	}
+	if _, err := EncodeText("가", "euc-jis-2004"); err == nil {
+		t.Error("expected error for unencodable text")
+	}
+}
+
+func TestEncodeText_plane2(t *testing.T) {
+	// every character of JIS X 0213 plane 2 is read back
+	for row, cells := range jisx0213_plane2 {
+		for cell, r := range cells {
+			if r == 0 {
+				continue
+			}
+			euc, err := EncodeText(string(r), "euc-jis-2004")
+			if err != nil || !bytes.Equal(euc, []byte{0x8f, row + 0xa0, byte(cell) + 0xa1}) {
+				t.Errorf("euc-jis-2004 %U: % x %v", r, euc, err)
+			}
+			sjis, err := EncodeText(string(r), "shift_jis-2004")
+			if err != nil || decode_sjis2004(sjis) != string(r) {
+				t.Errorf("shift_jis-2004 %U: % x %v", r, sjis, err)
+			}
+		}
+	}
+}
@@ -0,0 +1,8 @@
+package uhd
+
+// The tables of the encodings and the grapheme clusters are generated from
+// the files in testdata.
+
+//go:generate go run ../../tools/gen-jisx0213 testdata/euc-jis-2004.txt jisx0213.go
+//go:generate go run ../../tools/gen-ebcdic testdata ebcdic_tables.go
+//go:generate go run ../../tools/gen-grapheme testdata/ucd grapheme_tables.go
@@ -0,0 +1,243 @@
+package uhd
+
+import (
+	"slices"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+)
+
+// gcb is the Grapheme_Cluster_Break property of a code point.
+type gcb uint8
+
+const (
+	gcb_other gcb = iota
+	gcb_prepend
+	gcb_cr
+	gcb_lf
+	gcb_control
+	gcb_extend
+	gcb_regional_indicator
+	gcb_spacingmark
+	gcb_l
+	gcb_v
+	gcb_t
+	gcb_lv
+	gcb_lvt
+	gcb_zwj
+)
+
+type grapheme_range struct {
+	start rune
+	end   rune
+	prop  gcb
+}
+
+func grapheme_break(r rune) gcb {
+	idx, found := slices.BinarySearchFunc(grapheme_breaks, r, func(g grapheme_range, r rune) int {
+		switch {
+		case g.end < r:
+			return -1
+		case g.start > r:
+			return 1
+		}
+		return 0
+	})
+	if !found {
+		return gcb_other
+	}
+	return grapheme_breaks[idx].prop
+}
+
+// incb is the Indic_Conjunct_Break property of a code point.
+type incb uint8
+
+const (
+	incb_none incb = iota
+	incb_consonant
+	incb_extend
+	incb_linker
+)
+
+type incb_range struct {
+	start rune
+	end   rune
+	prop  incb
+}
+
+func indic_conjunct_break(r rune) incb {
+	idx, found := slices.BinarySearchFunc(indic_conjunct_breaks, r, func(g incb_range, r rune) int {
+		switch {
+		case g.end < r:
+			return -1
+		case g.start > r:
+			return 1
+		}
+		return 0
+	})
+	if !found {
+		return incb_none
+	}
+	return indic_conjunct_breaks[idx].prop
+}
+
+func is_extended_pictographic(r rune) bool {
+	_, found := slices.BinarySearchFunc(extended_pictographic, uint(r), func(u uintrange, r uint) int {
+		switch {
+		case u.end < r:
+			return -1
+		case u.start > r:
+			return 1
+		}
+		return 0
+	})
+	return found
+}
+
+// grapheme_boundary tells if there is a cluster boundary between two code
+// points of the properties prev and next, by the rules of UAX #29. emoji is
+// set when next is an Extended_Pictographic after an Extended_Pictographic,
+// Extend* and ZWJ, conjunct when next is a consonant after a consonant and
+// linkers, and ri counts the regional indicators before next.
+func grapheme_boundary(prev, next gcb, emoji, conjunct bool, ri int) bool {
+	switch {
+	case prev == gcb_cr && next == gcb_lf:
+		return false
+	case prev == gcb_cr || prev == gcb_lf || prev == gcb_control:
+		return true
+	case next == gcb_cr || next == gcb_lf || next == gcb_control:
+		return true
+	case prev == gcb_l && (next == gcb_l || next == gcb_v || next == gcb_lv || next == gcb_lvt):
+		return false
+	case (prev == gcb_lv || prev == gcb_v) && (next == gcb_v || next == gcb_t):
+		return false
+	case (prev == gcb_lvt || prev == gcb_t) && next == gcb_t:
+		return false
+	case next == gcb_extend || next == gcb_zwj || next == gcb_spacingmark:
+		return false
+	case prev == gcb_prepend:
+		return false
+	case conjunct:
+		return false
+	case prev == gcb_zwj && emoji:
+		return false
+	case prev == gcb_regional_indicator && next == gcb_regional_indicator:
+		return ri%2 == 0
+	}
+	return true
+}
+
+// grapheme_max is the most code points shown as one cluster: a starter and
+// the 30 non-starters of the stream-safe format of UAX #15.
+const grapheme_max = 31
+
+// grapheme_size returns the length of the extended grapheme cluster at the
+// top of p, which starts with a valid UTF-8 character. It returns 0 if the
+// cluster may go on after p, unless final is set. A cluster of more than
+// grapheme_max code points is cut after its first one, and capped is set.
+func grapheme_size(p []byte, final bool) (size int, capped bool) {
+	r, size := utf8.DecodeRune(p)
+	first := size
+	count := 1
+	prev := grapheme_break(r)
+	emoji := is_extended_pictographic(r)
+	zwj := false
+	// consonant is set after an InCB consonant and extends, and linked once
+	// a linker follows it
+	consonant := indic_conjunct_break(r) == incb_consonant
+	linked := false
+	ri := 0
+	if prev == gcb_regional_indicator {
+		ri = 1
+	}
+	for size < len(p) {
+		if !utf8.FullRune(p[size:]) {
+			break
+		}
+		r, n := utf8.DecodeRune(p[size:])
+		if r == utf8.RuneError && n == 1 {
+			return size, false
+		}
+		next := grapheme_break(r)
+		conjunct := indic_conjunct_break(r)
+		if grapheme_boundary(prev, next, zwj && is_extended_pictographic(r), linked && conjunct == incb_consonant, ri) {
+			return size, false
+		}
+		if count++; count > grapheme_max {
+			return first, true
+		}
+		zwj = emoji && next == gcb_zwj
+		emoji = is_extended_pictographic(r) || emoji && next == gcb_extend
+		switch {
+		case conjunct == incb_consonant:
+			consonant, linked = true, false
+		case conjunct == incb_linker:
+			linked = consonant
+		case conjunct != incb_extend:
+			consonant, linked = false, false
+		}
+		if next == gcb_regional_indicator {
+			ri++
+		} else {
+			ri = 0
+		}
+		prev = next
+		size += n
+	}
+	if final {
+		return size, false
+	}
+	return 0, false
+}
+
+// continues_cluster tells if r never starts a cluster.
+func continues_cluster(r rune) bool {
+	switch grapheme_break(r) {
+	case gcb_extend, gcb_zwj, gcb_spacingmark:
+		return true
+	}
+	return false
+}
+
+// grapheme_width returns the cells of the cluster s in a terminal: an emoji
+// sequence, a keycap or a flag takes the cells of one emoji, and otherwise the code
+// points add up, without the marks, format characters and the vowels and
+// final consonants of conjoining jamo.
+func grapheme_width(s string) int {
+	r, size := utf8.DecodeRuneInString(s)
+	emoji := is_extended_pictographic(r) || strings.ContainsRune("0123456789#*", r)
+	if grapheme_break(r) == gcb_regional_indicator && len(s) > size || emoji && strings.ContainsRune(s, 0xfe0f) {
+		return 2
+	}
+	res := runeWidth(r)
+	if emoji {
+		return res
+	}
+	for _, r := range s[size:] {
+		switch {
+		case unicode.In(r, unicode.Mn, unicode.Me, unicode.Cf):
+		case grapheme_break(r) == gcb_v || grapheme_break(r) == gcb_t:
+		default:
+			res += runeWidth(r)
+		}
+	}
+	return res
+}
+
+// grapheme_text returns how the cluster or code point s is shown: "" if it
+// is a control or otherwise not printable, and a mark without a base on a
+// dotted circle.
+func grapheme_text(s string) string {
+	r, size := utf8.DecodeRuneInString(s)
+	switch grapheme_break(r) {
+	case gcb_cr, gcb_lf, gcb_control:
+		return ""
+	}
+	if unicode.Is(unicode.M, r) {
+		return "◌" + s
+	}
+	if !unicode.IsPrint(r) && (grapheme_break(r) != gcb_prepend || len(s) == size) {
+		return ""
+	}
+	return s
+}
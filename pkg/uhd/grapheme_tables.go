@@ -0,0 +1,2032 @@
+// Code generated by gen-grapheme from the Unicode 17.0.0 files of testdata/ucd; DO NOT EDIT.
+
+package uhd
+
+// grapheme_breaks holds the Grapheme_Cluster_Break property of UAX #29 by
+// range of code points. Code points not listed are gcb_other.
+var grapheme_breaks = []grapheme_range{
+	{0x0000, 0x0009, gcb_control},
+	{0x000a, 0x000a, gcb_lf},
+	{0x000b, 0x000c, gcb_control},
+	{0x000d, 0x000d, gcb_cr},
+	{0x000e, 0x001f, gcb_control},
+	{0x007f, 0x009f, gcb_control},
+	{0x00ad, 0x00ad, gcb_control},
+	{0x0300, 0x036f, gcb_extend},
+	{0x0483, 0x0489, gcb_extend},
+	{0x0591, 0x05bd, gcb_extend},
+	{0x05bf, 0x05bf, gcb_extend},
+	{0x05c1, 0x05c2, gcb_extend},
+	{0x05c4, 0x05c5, gcb_extend},
+	{0x05c7, 0x05c7, gcb_extend},
+	{0x0600, 0x0605, gcb_prepend},
+	{0x0610, 0x061a, gcb_extend},
+	{0x061c, 0x061c, gcb_control},
+	{0x064b, 0x065f, gcb_extend},
+	{0x0670, 0x0670, gcb_extend},
+	{0x06d6, 0x06dc, gcb_extend},
+	{0x06dd, 0x06dd, gcb_prepend},
+	{0x06df, 0x06e4, gcb_extend},
+	{0x06e7, 0x06e8, gcb_extend},
+	{0x06ea, 0x06ed, gcb_extend},
+	{0x070f, 0x070f, gcb_prepend},
+	{0x0711, 0x0711, gcb_extend},
+	{0x0730, 0x074a, gcb_extend},
+	{0x07a6, 0x07b0, gcb_extend},
+	{0x07eb, 0x07f3, gcb_extend},
+	{0x07fd, 0x07fd, gcb_extend},
+	{0x0816, 0x0819, gcb_extend},
+	{0x081b, 0x0823, gcb_extend},
+	{0x0825, 0x0827, gcb_extend},
+	{0x0829, 0x082d, gcb_extend},
+	{0x0859, 0x085b, gcb_extend},
+	{0x0890, 0x0891, gcb_prepend},
+	{0x0897, 0x089f, gcb_extend},
+	{0x08ca, 0x08e1, gcb_extend},
+	{0x08e2, 0x08e2, gcb_prepend},
+	{0x08e3, 0x0902, gcb_extend},
+	{0x0903, 0x0903, gcb_spacingmark},
+	{0x093a, 0x093a, gcb_extend},
+	{0x093b, 0x093b, gcb_spacingmark},
+	{0x093c, 0x093c, gcb_extend},
+	{0x093e, 0x0940, gcb_spacingmark},
+	{0x0941, 0x0948, gcb_extend},
+	{0x0949, 0x094c, gcb_spacingmark},
+	{0x094d, 0x094d, gcb_extend},
+	{0x094e, 0x094f, gcb_spacingmark},
+	{0x0951, 0x0957, gcb_extend},
+	{0x0962, 0x0963, gcb_extend},
+	{0x0981, 0x0981, gcb_extend},
+	{0x0982, 0x0983, gcb_spacingmark},
+	{0x09bc, 0x09bc, gcb_extend},
+	{0x09be, 0x09be, gcb_extend},
+	{0x09bf, 0x09c0, gcb_spacingmark},
+	{0x09c1, 0x09c4, gcb_extend},
+	{0x09c7, 0x09c8, gcb_spacingmark},
+	{0x09cb, 0x09cc, gcb_spacingmark},
+	{0x09cd, 0x09cd, gcb_extend},
+	{0x09d7, 0x09d7, gcb_extend},
+	{0x09e2, 0x09e3, gcb_extend},
+	{0x09fe, 0x09fe, gcb_extend},
+	{0x0a01, 0x0a02, gcb_extend},
+	{0x0a03, 0x0a03, gcb_spacingmark},
+	{0x0a3c, 0x0a3c, gcb_extend},
+	{0x0a3e, 0x0a40, gcb_spacingmark},
+	{0x0a41, 0x0a42, gcb_extend},
+	{0x0a47, 0x0a48, gcb_extend},
+	{0x0a4b, 0x0a4d, gcb_extend},
+	{0x0a51, 0x0a51, gcb_extend},
+	{0x0a70, 0x0a71, gcb_extend},
+	{0x0a75, 0x0a75, gcb_extend},
+	{0x0a81, 0x0a82, gcb_extend},
+	{0x0a83, 0x0a83, gcb_spacingmark},
+	{0x0abc, 0x0abc, gcb_extend},
+	{0x0abe, 0x0ac0, gcb_spacingmark},
+	{0x0ac1, 0x0ac5, gcb_extend},
+	{0x0ac7, 0x0ac8, gcb_extend},
+	{0x0ac9, 0x0ac9, gcb_spacingmark},
+	{0x0acb, 0x0acc, gcb_spacingmark},
+	{0x0acd, 0x0acd, gcb_extend},
+	{0x0ae2, 0x0ae3, gcb_extend},
+	{0x0afa, 0x0aff, gcb_extend},
+	{0x0b01, 0x0b01, gcb_extend},
+	{0x0b02, 0x0b03, gcb_spacingmark},
+	{0x0b3c, 0x0b3c, gcb_extend},
+	{0x0b3e, 0x0b3f, gcb_extend},
+	{0x0b40, 0x0b40, gcb_spacingmark},
+	{0x0b41, 0x0b44, gcb_extend},
+	{0x0b47, 0x0b48, gcb_spacingmark},
+	{0x0b4b, 0x0b4c, gcb_spacingmark},
+	{0x0b4d, 0x0b4d, gcb_extend},
+	{0x0b55, 0x0b57, gcb_extend},
+	{0x0b62, 0x0b63, gcb_extend},
+	{0x0b82, 0x0b82, gcb_extend},
+	{0x0bbe, 0x0bbe, gcb_extend},
+	{0x0bbf, 0x0bbf, gcb_spacingmark},
+	{0x0bc0, 0x0bc0, gcb_extend},
+	{0x0bc1, 0x0bc2, gcb_spacingmark},
+	{0x0bc6, 0x0bc8, gcb_spacingmark},
+	{0x0bca, 0x0bcc, gcb_spacingmark},
+	{0x0bcd, 0x0bcd, gcb_extend},
+	{0x0bd7, 0x0bd7, gcb_extend},
+	{0x0c00, 0x0c00, gcb_extend},
+	{0x0c01, 0x0c03, gcb_spacingmark},
+	{0x0c04, 0x0c04, gcb_extend},
+	{0x0c3c, 0x0c3c, gcb_extend},
+	{0x0c3e, 0x0c40, gcb_extend},
+	{0x0c41, 0x0c44, gcb_spacingmark},
+	{0x0c46, 0x0c48, gcb_extend},
+	{0x0c4a, 0x0c4d, gcb_extend},
+	{0x0c55, 0x0c56, gcb_extend},
+	{0x0c62, 0x0c63, gcb_extend},
+	{0x0c81, 0x0c81, gcb_extend},
+	{0x0c82, 0x0c83, gcb_spacingmark},
+	{0x0cbc, 0x0cbc, gcb_extend},
+	{0x0cbe, 0x0cbe, gcb_spacingmark},
+	{0x0cbf, 0x0cc0, gcb_extend},
+	{0x0cc1, 0x0cc1, gcb_spacingmark},
+	{0x0cc2, 0x0cc2, gcb_extend},
+	{0x0cc3, 0x0cc4, gcb_spacingmark},
+	{0x0cc6, 0x0cc8, gcb_extend},
+	{0x0cca, 0x0ccd, gcb_extend},
+	{0x0cd5, 0x0cd6, gcb_extend},
+	{0x0ce2, 0x0ce3, gcb_extend},
+	{0x0cf3, 0x0cf3, gcb_spacingmark},
+	{0x0d00, 0x0d01, gcb_extend},
+	{0x0d02, 0x0d03, gcb_spacingmark},
+	{0x0d3b, 0x0d3c, gcb_extend},
+	{0x0d3e, 0x0d3e, gcb_extend},
+	{0x0d3f, 0x0d40, gcb_spacingmark},
+	{0x0d41, 0x0d44, gcb_extend},
+	{0x0d46, 0x0d48, gcb_spacingmark},
+	{0x0d4a, 0x0d4c, gcb_spacingmark},
+	{0x0d4d, 0x0d4d, gcb_extend},
+	{0x0d4e, 0x0d4e, gcb_prepend},
+	{0x0d57, 0x0d57, gcb_extend},
+	{0x0d62, 0x0d63, gcb_extend},
+	{0x0d81, 0x0d81, gcb_extend},
+	{0x0d82, 0x0d83, gcb_spacingmark},
+	{0x0dca, 0x0dca, gcb_extend},
+	{0x0dcf, 0x0dcf, gcb_extend},
+	{0x0dd0, 0x0dd1, gcb_spacingmark},
+	{0x0dd2, 0x0dd4, gcb_extend},
+	{0x0dd6, 0x0dd6, gcb_extend},
+	{0x0dd8, 0x0dde, gcb_spacingmark},
+	{0x0ddf, 0x0ddf, gcb_extend},
+	{0x0df2, 0x0df3, gcb_spacingmark},
+	{0x0e31, 0x0e31, gcb_extend},
+	{0x0e33, 0x0e33, gcb_spacingmark},
+	{0x0e34, 0x0e3a, gcb_extend},
+	{0x0e47, 0x0e4e, gcb_extend},
+	{0x0eb1, 0x0eb1, gcb_extend},
+	{0x0eb3, 0x0eb3, gcb_spacingmark},
+	{0x0eb4, 0x0ebc, gcb_extend},
+	{0x0ec8, 0x0ece, gcb_extend},
+	{0x0f18, 0x0f19, gcb_extend},
+	{0x0f35, 0x0f35, gcb_extend},
+	{0x0f37, 0x0f37, gcb_extend},
+	{0x0f39, 0x0f39, gcb_extend},
+	{0x0f3e, 0x0f3f, gcb_spacingmark},
+	{0x0f71, 0x0f7e, gcb_extend},
+	{0x0f7f, 0x0f7f, gcb_spacingmark},
+	{0x0f80, 0x0f84, gcb_extend},
+	{0x0f86, 0x0f87, gcb_extend},
+	{0x0f8d, 0x0f97, gcb_extend},
+	{0x0f99, 0x0fbc, gcb_extend},
+	{0x0fc6, 0x0fc6, gcb_extend},
+	{0x102d, 0x1030, gcb_extend},
+	{0x1031, 0x1031, gcb_spacingmark},
+	{0x1032, 0x1037, gcb_extend},
+	{0x1039, 0x103a, gcb_extend},
+	{0x103b, 0x103c, gcb_spacingmark},
+	{0x103d, 0x103e, gcb_extend},
+	{0x1056, 0x1057, gcb_spacingmark},
+	{0x1058, 0x1059, gcb_extend},
+	{0x105e, 0x1060, gcb_extend},
+	{0x1071, 0x1074, gcb_extend},
+	{0x1082, 0x1082, gcb_extend},
+	{0x1084, 0x1084, gcb_spacingmark},
+	{0x1085, 0x1086, gcb_extend},
+	{0x108d, 0x108d, gcb_extend},
+	{0x109d, 0x109d, gcb_extend},
+	{0x1100, 0x115f, gcb_l},
+	{0x1160, 0x11a7, gcb_v},
+	{0x11a8, 0x11ff, gcb_t},
+	{0x135d, 0x135f, gcb_extend},
+	{0x1712, 0x1715, gcb_extend},
+	{0x1732, 0x1734, gcb_extend},
+	{0x1752, 0x1753, gcb_extend},
+	{0x1772, 0x1773, gcb_extend},
+	{0x17b4, 0x17b5, gcb_extend},
+	{0x17b6, 0x17b6, gcb_spacingmark},
+	{0x17b7, 0x17bd, gcb_extend},
+	{0x17be, 0x17c5, gcb_spacingmark},
+	{0x17c6, 0x17c6, gcb_extend},
+	{0x17c7, 0x17c8, gcb_spacingmark},
+	{0x17c9, 0x17d3, gcb_extend},
+	{0x17dd, 0x17dd, gcb_extend},
+	{0x180b, 0x180d, gcb_extend},
+	{0x180e, 0x180e, gcb_control},
+	{0x180f, 0x180f, gcb_extend},
+	{0x1885, 0x1886, gcb_extend},
+	{0x18a9, 0x18a9, gcb_extend},
+	{0x1920, 0x1922, gcb_extend},
+	{0x1923, 0x1926, gcb_spacingmark},
+	{0x1927, 0x1928, gcb_extend},
+	{0x1929, 0x192b, gcb_spacingmark},
+	{0x1930, 0x1931, gcb_spacingmark},
+	{0x1932, 0x1932, gcb_extend},
+	{0x1933, 0x1938, gcb_spacingmark},
+	{0x1939, 0x193b, gcb_extend},
+	{0x1a17, 0x1a18, gcb_extend},
+	{0x1a19, 0x1a1a, gcb_spacingmark},
+	{0x1a1b, 0x1a1b, gcb_extend},
+	{0x1a55, 0x1a55, gcb_spacingmark},
+	{0x1a56, 0x1a56, gcb_extend},
+	{0x1a57, 0x1a57, gcb_spacingmark},
+	{0x1a58, 0x1a5e, gcb_extend},
+	{0x1a60, 0x1a60, gcb_extend},
+	{0x1a62, 0x1a62, gcb_extend},
+	{0x1a65, 0x1a6c, gcb_extend},
+	{0x1a6d, 0x1a72, gcb_spacingmark},
+	{0x1a73, 0x1a7c, gcb_extend},
+	{0x1a7f, 0x1a7f, gcb_extend},
+	{0x1ab0, 0x1add, gcb_extend},
+	{0x1ae0, 0x1aeb, gcb_extend},
+	{0x1b00, 0x1b03, gcb_extend},
+	{0x1b04, 0x1b04, gcb_spacingmark},
+	{0x1b34, 0x1b3d, gcb_extend},
+	{0x1b3e, 0x1b41, gcb_spacingmark},
+	{0x1b42, 0x1b44, gcb_extend},
+	{0x1b6b, 0x1b73, gcb_extend},
+	{0x1b80, 0x1b81, gcb_extend},
+	{0x1b82, 0x1b82, gcb_spacingmark},
+	{0x1ba1, 0x1ba1, gcb_spacingmark},
+	{0x1ba2, 0x1ba5, gcb_extend},
+	{0x1ba6, 0x1ba7, gcb_spacingmark},
+	{0x1ba8, 0x1bad, gcb_extend},
+	{0x1be6, 0x1be6, gcb_extend},
+	{0x1be7, 0x1be7, gcb_spacingmark},
+	{0x1be8, 0x1be9, gcb_extend},
+	{0x1bea, 0x1bec, gcb_spacingmark},
+	{0x1bed, 0x1bed, gcb_extend},
+	{0x1bee, 0x1bee, gcb_spacingmark},
+	{0x1bef, 0x1bf3, gcb_extend},
+	{0x1c24, 0x1c2b, gcb_spacingmark},
+	{0x1c2c, 0x1c33, gcb_extend},
+	{0x1c34, 0x1c35, gcb_spacingmark},
+	{0x1c36, 0x1c37, gcb_extend},
+	{0x1cd0, 0x1cd2, gcb_extend},
+	{0x1cd4, 0x1ce0, gcb_extend},
+	{0x1ce1, 0x1ce1, gcb_spacingmark},
+	{0x1ce2, 0x1ce8, gcb_extend},
+	{0x1ced, 0x1ced, gcb_extend},
+	{0x1cf4, 0x1cf4, gcb_extend},
+	{0x1cf7, 0x1cf7, gcb_spacingmark},
+	{0x1cf8, 0x1cf9, gcb_extend},
+	{0x1dc0, 0x1dff, gcb_extend},
+	{0x200b, 0x200b, gcb_control},
+	{0x200c, 0x200c, gcb_extend},
+	{0x200d, 0x200d, gcb_zwj},
+	{0x200e, 0x200f, gcb_control},
+	{0x2028, 0x202e, gcb_control},
+	{0x2060, 0x206f, gcb_control},
+	{0x20d0, 0x20f0, gcb_extend},
+	{0x2cef, 0x2cf1, gcb_extend},
+	{0x2d7f, 0x2d7f, gcb_extend},
+	{0x2de0, 0x2dff, gcb_extend},
+	{0x302a, 0x302f, gcb_extend},
+	{0x3099, 0x309a, gcb_extend},
+	{0xa66f, 0xa672, gcb_extend},
+	{0xa674, 0xa67d, gcb_extend},
+	{0xa69e, 0xa69f, gcb_extend},
+	{0xa6f0, 0xa6f1, gcb_extend},
+	{0xa802, 0xa802, gcb_extend},
+	{0xa806, 0xa806, gcb_extend},
+	{0xa80b, 0xa80b, gcb_extend},
+	{0xa823, 0xa824, gcb_spacingmark},
+	{0xa825, 0xa826, gcb_extend},
+	{0xa827, 0xa827, gcb_spacingmark},
+	{0xa82c, 0xa82c, gcb_extend},
+	{0xa880, 0xa881, gcb_spacingmark},
+	{0xa8b4, 0xa8c3, gcb_spacingmark},
+	{0xa8c4, 0xa8c5, gcb_extend},
+	{0xa8e0, 0xa8f1, gcb_extend},
+	{0xa8ff, 0xa8ff, gcb_extend},
+	{0xa926, 0xa92d, gcb_extend},
+	{0xa947, 0xa951, gcb_extend},
+	{0xa952, 0xa952, gcb_spacingmark},
+	{0xa953, 0xa953, gcb_extend},
+	{0xa960, 0xa97c, gcb_l},
+	{0xa980, 0xa982, gcb_extend},
+	{0xa983, 0xa983, gcb_spacingmark},
+	{0xa9b3, 0xa9b3, gcb_extend},
+	{0xa9b4, 0xa9b5, gcb_spacingmark},
+	{0xa9b6, 0xa9b9, gcb_extend},
+	{0xa9ba, 0xa9bb, gcb_spacingmark},
+	{0xa9bc, 0xa9bd, gcb_extend},
+	{0xa9be, 0xa9bf, gcb_spacingmark},
+	{0xa9c0, 0xa9c0, gcb_extend},
+	{0xa9e5, 0xa9e5, gcb_extend},
+	{0xaa29, 0xaa2e, gcb_extend},
+	{0xaa2f, 0xaa30, gcb_spacingmark},
+	{0xaa31, 0xaa32, gcb_extend},
+	{0xaa33, 0xaa34, gcb_spacingmark},
+	{0xaa35, 0xaa36, gcb_extend},
+	{0xaa43, 0xaa43, gcb_extend},
+	{0xaa4c, 0xaa4c, gcb_extend},
+	{0xaa4d, 0xaa4d, gcb_spacingmark},
+	{0xaa7c, 0xaa7c, gcb_extend},
+	{0xaab0, 0xaab0, gcb_extend},
+	{0xaab2, 0xaab4, gcb_extend},
+	{0xaab7, 0xaab8, gcb_extend},
+	{0xaabe, 0xaabf, gcb_extend},
+	{0xaac1, 0xaac1, gcb_extend},
+	{0xaaeb, 0xaaeb, gcb_spacingmark},
+	{0xaaec, 0xaaed, gcb_extend},
+	{0xaaee, 0xaaef, gcb_spacingmark},
+	{0xaaf5, 0xaaf5, gcb_spacingmark},
+	{0xaaf6, 0xaaf6, gcb_extend},
+	{0xabe3, 0xabe4, gcb_spacingmark},
+	{0xabe5, 0xabe5, gcb_extend},
+	{0xabe6, 0xabe7, gcb_spacingmark},
+	{0xabe8, 0xabe8, gcb_extend},
+	{0xabe9, 0xabea, gcb_spacingmark},
+	{0xabec, 0xabec, gcb_spacingmark},
+	{0xabed, 0xabed, gcb_extend},
+	{0xac00, 0xac00, gcb_lv},
+	{0xac01, 0xac1b, gcb_lvt},
+	{0xac1c, 0xac1c, gcb_lv},
+	{0xac1d, 0xac37, gcb_lvt},
+	{0xac38, 0xac38, gcb_lv},
+	{0xac39, 0xac53, gcb_lvt},
+	{0xac54, 0xac54, gcb_lv},
+	{0xac55, 0xac6f, gcb_lvt},
+	{0xac70, 0xac70, gcb_lv},
+	{0xac71, 0xac8b, gcb_lvt},
+	{0xac8c, 0xac8c, gcb_lv},
+	{0xac8d, 0xaca7, gcb_lvt},
+	{0xaca8, 0xaca8, gcb_lv},
+	{0xaca9, 0xacc3, gcb_lvt},
+	{0xacc4, 0xacc4, gcb_lv},
+	{0xacc5, 0xacdf, gcb_lvt},
+	{0xace0, 0xace0, gcb_lv},
+	{0xace1, 0xacfb, gcb_lvt},
+	{0xacfc, 0xacfc, gcb_lv},
+	{0xacfd, 0xad17, gcb_lvt},
+	{0xad18, 0xad18, gcb_lv},
+	{0xad19, 0xad33, gcb_lvt},
+	{0xad34, 0xad34, gcb_lv},
+	{0xad35, 0xad4f, gcb_lvt},
+	{0xad50, 0xad50, gcb_lv},
+	{0xad51, 0xad6b, gcb_lvt},
+	{0xad6c, 0xad6c, gcb_lv},
+	{0xad6d, 0xad87, gcb_lvt},
+	{0xad88, 0xad88, gcb_lv},
+	{0xad89, 0xada3, gcb_lvt},
+	{0xada4, 0xada4, gcb_lv},
+	{0xada5, 0xadbf, gcb_lvt},
+	{0xadc0, 0xadc0, gcb_lv},
+	{0xadc1, 0xaddb, gcb_lvt},
+	{0xaddc, 0xaddc, gcb_lv},
+	{0xaddd, 0xadf7, gcb_lvt},
+	{0xadf8, 0xadf8, gcb_lv},
+	{0xadf9, 0xae13, gcb_lvt},
+	{0xae14, 0xae14, gcb_lv},
+	{0xae15, 0xae2f, gcb_lvt},
+	{0xae30, 0xae30, gcb_lv},
+	{0xae31, 0xae4b, gcb_lvt},
+	{0xae4c, 0xae4c, gcb_lv},
+	{0xae4d, 0xae67, gcb_lvt},
+	{0xae68, 0xae68, gcb_lv},
+	{0xae69, 0xae83, gcb_lvt},
+	{0xae84, 0xae84, gcb_lv},
+	{0xae85, 0xae9f, gcb_lvt},
+	{0xaea0, 0xaea0, gcb_lv},
+	{0xaea1, 0xaebb, gcb_lvt},
+	{0xaebc, 0xaebc, gcb_lv},
+	{0xaebd, 0xaed7, gcb_lvt},
+	{0xaed8, 0xaed8, gcb_lv},
+	{0xaed9, 0xaef3, gcb_lvt},
+	{0xaef4, 0xaef4, gcb_lv},
+	{0xaef5, 0xaf0f, gcb_lvt},
+	{0xaf10, 0xaf10, gcb_lv},
+	{0xaf11, 0xaf2b, gcb_lvt},
+	{0xaf2c, 0xaf2c, gcb_lv},
+	{0xaf2d, 0xaf47, gcb_lvt},
+	{0xaf48, 0xaf48, gcb_lv},
+	{0xaf49, 0xaf63, gcb_lvt},
+	{0xaf64, 0xaf64, gcb_lv},
+	{0xaf65, 0xaf7f, gcb_lvt},
+	{0xaf80, 0xaf80, gcb_lv},
+	{0xaf81, 0xaf9b, gcb_lvt},
+	{0xaf9c, 0xaf9c, gcb_lv},
+	{0xaf9d, 0xafb7, gcb_lvt},
+	{0xafb8, 0xafb8, gcb_lv},
+	{0xafb9, 0xafd3, gcb_lvt},
+	{0xafd4, 0xafd4, gcb_lv},
+	{0xafd5, 0xafef, gcb_lvt},
+	{0xaff0, 0xaff0, gcb_lv},
+	{0xaff1, 0xb00b, gcb_lvt},
+	{0xb00c, 0xb00c, gcb_lv},
+	{0xb00d, 0xb027, gcb_lvt},
+	{0xb028, 0xb028, gcb_lv},
+	{0xb029, 0xb043, gcb_lvt},
+	{0xb044, 0xb044, gcb_lv},
+	{0xb045, 0xb05f, gcb_lvt},
+	{0xb060, 0xb060, gcb_lv},
+	{0xb061, 0xb07b, gcb_lvt},
+	{0xb07c, 0xb07c, gcb_lv},
+	{0xb07d, 0xb097, gcb_lvt},
+	{0xb098, 0xb098, gcb_lv},
+	{0xb099, 0xb0b3, gcb_lvt},
+	{0xb0b4, 0xb0b4, gcb_lv},
+	{0xb0b5, 0xb0cf, gcb_lvt},
+	{0xb0d0, 0xb0d0, gcb_lv},
+	{0xb0d1, 0xb0eb, gcb_lvt},
+	{0xb0ec, 0xb0ec, gcb_lv},
+	{0xb0ed, 0xb107, gcb_lvt},
+	{0xb108, 0xb108, gcb_lv},
+	{0xb109, 0xb123, gcb_lvt},
+	{0xb124, 0xb124, gcb_lv},
+	{0xb125, 0xb13f, gcb_lvt},
+	{0xb140, 0xb140, gcb_lv},
+	{0xb141, 0xb15b, gcb_lvt},
+	{0xb15c, 0xb15c, gcb_lv},
+	{0xb15d, 0xb177, gcb_lvt},
+	{0xb178, 0xb178, gcb_lv},
+	{0xb179, 0xb193, gcb_lvt},
+	{0xb194, 0xb194, gcb_lv},
+	{0xb195, 0xb1af, gcb_lvt},
+	{0xb1b0, 0xb1b0, gcb_lv},
+	{0xb1b1, 0xb1cb, gcb_lvt},
+	{0xb1cc, 0xb1cc, gcb_lv},
+	{0xb1cd, 0xb1e7, gcb_lvt},
+	{0xb1e8, 0xb1e8, gcb_lv},
+	{0xb1e9, 0xb203, gcb_lvt},
+	{0xb204, 0xb204, gcb_lv},
+	{0xb205, 0xb21f, gcb_lvt},
+	{0xb220, 0xb220, gcb_lv},
+	{0xb221, 0xb23b, gcb_lvt},
+	{0xb23c, 0xb23c, gcb_lv},
+	{0xb23d, 0xb257, gcb_lvt},
+	{0xb258, 0xb258, gcb_lv},
+	{0xb259, 0xb273, gcb_lvt},
+	{0xb274, 0xb274, gcb_lv},
+	{0xb275, 0xb28f, gcb_lvt},
+	{0xb290, 0xb290, gcb_lv},
+	{0xb291, 0xb2ab, gcb_lvt},
+	{0xb2ac, 0xb2ac, gcb_lv},
+	{0xb2ad, 0xb2c7, gcb_lvt},
+	{0xb2c8, 0xb2c8, gcb_lv},
+	{0xb2c9, 0xb2e3, gcb_lvt},
+	{0xb2e4, 0xb2e4, gcb_lv},
+	{0xb2e5, 0xb2ff, gcb_lvt},
+	{0xb300, 0xb300, gcb_lv},
+	{0xb301, 0xb31b, gcb_lvt},
+	{0xb31c, 0xb31c, gcb_lv},
+	{0xb31d, 0xb337, gcb_lvt},
+	{0xb338, 0xb338, gcb_lv},
+	{0xb339, 0xb353, gcb_lvt},
+	{0xb354, 0xb354, gcb_lv},
+	{0xb355, 0xb36f, gcb_lvt},
+	{0xb370, 0xb370, gcb_lv},
+	{0xb371, 0xb38b, gcb_lvt},
+	{0xb38c, 0xb38c, gcb_lv},
+	{0xb38d, 0xb3a7, gcb_lvt},
+	{0xb3a8, 0xb3a8, gcb_lv},
+	{0xb3a9, 0xb3c3, gcb_lvt},
+	{0xb3c4, 0xb3c4, gcb_lv},
+	{0xb3c5, 0xb3df, gcb_lvt},
+	{0xb3e0, 0xb3e0, gcb_lv},
+	{0xb3e1, 0xb3fb, gcb_lvt},
+	{0xb3fc, 0xb3fc, gcb_lv},
+	{0xb3fd, 0xb417, gcb_lvt},
+	{0xb418, 0xb418, gcb_lv},
+	{0xb419, 0xb433, gcb_lvt},
+	{0xb434, 0xb434, gcb_lv},
+	{0xb435, 0xb44f, gcb_lvt},
+	{0xb450, 0xb450, gcb_lv},
+	{0xb451, 0xb46b, gcb_lvt},
+	{0xb46c, 0xb46c, gcb_lv},
+	{0xb46d, 0xb487, gcb_lvt},
+	{0xb488, 0xb488, gcb_lv},
+	{0xb489, 0xb4a3, gcb_lvt},
+	{0xb4a4, 0xb4a4, gcb_lv},
+	{0xb4a5, 0xb4bf, gcb_lvt},
+	{0xb4c0, 0xb4c0, gcb_lv},
+	{0xb4c1, 0xb4db, gcb_lvt},
+	{0xb4dc, 0xb4dc, gcb_lv},
+	{0xb4dd, 0xb4f7, gcb_lvt},
+	{0xb4f8, 0xb4f8, gcb_lv},
+	{0xb4f9, 0xb513, gcb_lvt},
+	{0xb514, 0xb514, gcb_lv},
+	{0xb515, 0xb52f, gcb_lvt},
+	{0xb530, 0xb530, gcb_lv},
+	{0xb531, 0xb54b, gcb_lvt},
+	{0xb54c, 0xb54c, gcb_lv},
+	{0xb54d, 0xb567, gcb_lvt},
+	{0xb568, 0xb568, gcb_lv},
+	{0xb569, 0xb583, gcb_lvt},
+	{0xb584, 0xb584, gcb_lv},
+	{0xb585, 0xb59f, gcb_lvt},
+	{0xb5a0, 0xb5a0, gcb_lv},
+	{0xb5a1, 0xb5bb, gcb_lvt},
+	{0xb5bc, 0xb5bc, gcb_lv},
+	{0xb5bd, 0xb5d7, gcb_lvt},
+	{0xb5d8, 0xb5d8, gcb_lv},
+	{0xb5d9, 0xb5f3, gcb_lvt},
+	{0xb5f4, 0xb5f4, gcb_lv},
+	{0xb5f5, 0xb60f, gcb_lvt},
+	{0xb610, 0xb610, gcb_lv},
+	{0xb611, 0xb62b, gcb_lvt},
+	{0xb62c, 0xb62c, gcb_lv},
+	{0xb62d, 0xb647, gcb_lvt},
+	{0xb648, 0xb648, gcb_lv},
+	{0xb649, 0xb663, gcb_lvt},
+	{0xb664, 0xb664, gcb_lv},
+	{0xb665, 0xb67f, gcb_lvt},
+	{0xb680, 0xb680, gcb_lv},
+	{0xb681, 0xb69b, gcb_lvt},
+	{0xb69c, 0xb69c, gcb_lv},
+	{0xb69d, 0xb6b7, gcb_lvt},
+	{0xb6b8, 0xb6b8, gcb_lv},
+	{0xb6b9, 0xb6d3, gcb_lvt},
+	{0xb6d4, 0xb6d4, gcb_lv},
+	{0xb6d5, 0xb6ef, gcb_lvt},
+	{0xb6f0, 0xb6f0, gcb_lv},
+	{0xb6f1, 0xb70b, gcb_lvt},
+	{0xb70c, 0xb70c, gcb_lv},
+	{0xb70d, 0xb727, gcb_lvt},
+	{0xb728, 0xb728, gcb_lv},
+	{0xb729, 0xb743, gcb_lvt},
+	{0xb744, 0xb744, gcb_lv},
+	{0xb745, 0xb75f, gcb_lvt},
+	{0xb760, 0xb760, gcb_lv},
+	{0xb761, 0xb77b, gcb_lvt},
+	{0xb77c, 0xb77c, gcb_lv},
+	{0xb77d, 0xb797, gcb_lvt},
+	{0xb798, 0xb798, gcb_lv},
+	{0xb799, 0xb7b3, gcb_lvt},
+	{0xb7b4, 0xb7b4, gcb_lv},
+	{0xb7b5, 0xb7cf, gcb_lvt},
+	{0xb7d0, 0xb7d0, gcb_lv},
+	{0xb7d1, 0xb7eb, gcb_lvt},
+	{0xb7ec, 0xb7ec, gcb_lv},
+	{0xb7ed, 0xb807, gcb_lvt},
+	{0xb808, 0xb808, gcb_lv},
+	{0xb809, 0xb823, gcb_lvt},
+	{0xb824, 0xb824, gcb_lv},
+	{0xb825, 0xb83f, gcb_lvt},
+	{0xb840, 0xb840, gcb_lv},
+	{0xb841, 0xb85b, gcb_lvt},
+	{0xb85c, 0xb85c, gcb_lv},
+	{0xb85d, 0xb877, gcb_lvt},
+	{0xb878, 0xb878, gcb_lv},
+	{0xb879, 0xb893, gcb_lvt},
+	{0xb894, 0xb894, gcb_lv},
+	{0xb895, 0xb8af, gcb_lvt},
+	{0xb8b0, 0xb8b0, gcb_lv},
+	{0xb8b1, 0xb8cb, gcb_lvt},
+	{0xb8cc, 0xb8cc, gcb_lv},
+	{0xb8cd, 0xb8e7, gcb_lvt},
+	{0xb8e8, 0xb8e8, gcb_lv},
+	{0xb8e9, 0xb903, gcb_lvt},
+	{0xb904, 0xb904, gcb_lv},
+	{0xb905, 0xb91f, gcb_lvt},
+	{0xb920, 0xb920, gcb_lv},
+	{0xb921, 0xb93b, gcb_lvt},
+	{0xb93c, 0xb93c, gcb_lv},
+	{0xb93d, 0xb957, gcb_lvt},
+	{0xb958, 0xb958, gcb_lv},
+	{0xb959, 0xb973, gcb_lvt},
+	{0xb974, 0xb974, gcb_lv},
+	{0xb975, 0xb98f, gcb_lvt},
+	{0xb990, 0xb990, gcb_lv},
+	{0xb991, 0xb9ab, gcb_lvt},
+	{0xb9ac, 0xb9ac, gcb_lv},
+	{0xb9ad, 0xb9c7, gcb_lvt},
+	{0xb9c8, 0xb9c8, gcb_lv},
+	{0xb9c9, 0xb9e3, gcb_lvt},
+	{0xb9e4, 0xb9e4, gcb_lv},
+	{0xb9e5, 0xb9ff, gcb_lvt},
+	{0xba00, 0xba00, gcb_lv},
+	{0xba01, 0xba1b, gcb_lvt},
+	{0xba1c, 0xba1c, gcb_lv},
+	{0xba1d, 0xba37, gcb_lvt},
+	{0xba38, 0xba38, gcb_lv},
+	{0xba39, 0xba53, gcb_lvt},
+	{0xba54, 0xba54, gcb_lv},
+	{0xba55, 0xba6f, gcb_lvt},
+	{0xba70, 0xba70, gcb_lv},
+	{0xba71, 0xba8b, gcb_lvt},
+	{0xba8c, 0xba8c, gcb_lv},
+	{0xba8d, 0xbaa7, gcb_lvt},
+	{0xbaa8, 0xbaa8, gcb_lv},
+	{0xbaa9, 0xbac3, gcb_lvt},
+	{0xbac4, 0xbac4, gcb_lv},
+	{0xbac5, 0xbadf, gcb_lvt},
+	{0xbae0, 0xbae0, gcb_lv},
+	{0xbae1, 0xbafb, gcb_lvt},
+	{0xbafc, 0xbafc, gcb_lv},
+	{0xbafd, 0xbb17, gcb_lvt},
+	{0xbb18, 0xbb18, gcb_lv},
+	{0xbb19, 0xbb33, gcb_lvt},
+	{0xbb34, 0xbb34, gcb_lv},
+	{0xbb35, 0xbb4f, gcb_lvt},
+	{0xbb50, 0xbb50, gcb_lv},
+	{0xbb51, 0xbb6b, gcb_lvt},
+	{0xbb6c, 0xbb6c, gcb_lv},
+	{0xbb6d, 0xbb87, gcb_lvt},
+	{0xbb88, 0xbb88, gcb_lv},
+	{0xbb89, 0xbba3, gcb_lvt},
+	{0xbba4, 0xbba4, gcb_lv},
+	{0xbba5, 0xbbbf, gcb_lvt},
+	{0xbbc0, 0xbbc0, gcb_lv},
+	{0xbbc1, 0xbbdb, gcb_lvt},
+	{0xbbdc, 0xbbdc, gcb_lv},
+	{0xbbdd, 0xbbf7, gcb_lvt},
+	{0xbbf8, 0xbbf8, gcb_lv},
+	{0xbbf9, 0xbc13, gcb_lvt},
+	{0xbc14, 0xbc14, gcb_lv},
+	{0xbc15, 0xbc2f, gcb_lvt},
+	{0xbc30, 0xbc30, gcb_lv},
+	{0xbc31, 0xbc4b, gcb_lvt},
+	{0xbc4c, 0xbc4c, gcb_lv},
+	{0xbc4d, 0xbc67, gcb_lvt},
+	{0xbc68, 0xbc68, gcb_lv},
+	{0xbc69, 0xbc83, gcb_lvt},
+	{0xbc84, 0xbc84, gcb_lv},
+	{0xbc85, 0xbc9f, gcb_lvt},
+	{0xbca0, 0xbca0, gcb_lv},
+	{0xbca1, 0xbcbb, gcb_lvt},
+	{0xbcbc, 0xbcbc, gcb_lv},
+	{0xbcbd, 0xbcd7, gcb_lvt},
+	{0xbcd8, 0xbcd8, gcb_lv},
+	{0xbcd9, 0xbcf3, gcb_lvt},
+	{0xbcf4, 0xbcf4, gcb_lv},
+	{0xbcf5, 0xbd0f, gcb_lvt},
+	{0xbd10, 0xbd10, gcb_lv},
+	{0xbd11, 0xbd2b, gcb_lvt},
+	{0xbd2c, 0xbd2c, gcb_lv},
+	{0xbd2d, 0xbd47, gcb_lvt},
+	{0xbd48, 0xbd48, gcb_lv},
+	{0xbd49, 0xbd63, gcb_lvt},
+	{0xbd64, 0xbd64, gcb_lv},
+	{0xbd65, 0xbd7f, gcb_lvt},
+	{0xbd80, 0xbd80, gcb_lv},
+	{0xbd81, 0xbd9b, gcb_lvt},
+	{0xbd9c, 0xbd9c, gcb_lv},
+	{0xbd9d, 0xbdb7, gcb_lvt},
+	{0xbdb8, 0xbdb8, gcb_lv},
+	{0xbdb9, 0xbdd3, gcb_lvt},
+	{0xbdd4, 0xbdd4, gcb_lv},
+	{0xbdd5, 0xbdef, gcb_lvt},
+	{0xbdf0, 0xbdf0, gcb_lv},
+	{0xbdf1, 0xbe0b, gcb_lvt},
+	{0xbe0c, 0xbe0c, gcb_lv},
+	{0xbe0d, 0xbe27, gcb_lvt},
+	{0xbe28, 0xbe28, gcb_lv},
+	{0xbe29, 0xbe43, gcb_lvt},
+	{0xbe44, 0xbe44, gcb_lv},
+	{0xbe45, 0xbe5f, gcb_lvt},
+	{0xbe60, 0xbe60, gcb_lv},
+	{0xbe61, 0xbe7b, gcb_lvt},
+	{0xbe7c, 0xbe7c, gcb_lv},
+	{0xbe7d, 0xbe97, gcb_lvt},
+	{0xbe98, 0xbe98, gcb_lv},
+	{0xbe99, 0xbeb3, gcb_lvt},
+	{0xbeb4, 0xbeb4, gcb_lv},
+	{0xbeb5, 0xbecf, gcb_lvt},
+	{0xbed0, 0xbed0, gcb_lv},
+	{0xbed1, 0xbeeb, gcb_lvt},
+	{0xbeec, 0xbeec, gcb_lv},
+	{0xbeed, 0xbf07, gcb_lvt},
+	{0xbf08, 0xbf08, gcb_lv},
+	{0xbf09, 0xbf23, gcb_lvt},
+	{0xbf24, 0xbf24, gcb_lv},
+	{0xbf25, 0xbf3f, gcb_lvt},
+	{0xbf40, 0xbf40, gcb_lv},
+	{0xbf41, 0xbf5b, gcb_lvt},
+	{0xbf5c, 0xbf5c, gcb_lv},
+	{0xbf5d, 0xbf77, gcb_lvt},
+	{0xbf78, 0xbf78, gcb_lv},
+	{0xbf79, 0xbf93, gcb_lvt},
+	{0xbf94, 0xbf94, gcb_lv},
+	{0xbf95, 0xbfaf, gcb_lvt},
+	{0xbfb0, 0xbfb0, gcb_lv},
+	{0xbfb1, 0xbfcb, gcb_lvt},
+	{0xbfcc, 0xbfcc, gcb_lv},
+	{0xbfcd, 0xbfe7, gcb_lvt},
+	{0xbfe8, 0xbfe8, gcb_lv},
+	{0xbfe9, 0xc003, gcb_lvt},
+	{0xc004, 0xc004, gcb_lv},
+	{0xc005, 0xc01f, gcb_lvt},
+	{0xc020, 0xc020, gcb_lv},
+	{0xc021, 0xc03b, gcb_lvt},
+	{0xc03c, 0xc03c, gcb_lv},
+	{0xc03d, 0xc057, gcb_lvt},
+	{0xc058, 0xc058, gcb_lv},
+	{0xc059, 0xc073, gcb_lvt},
+	{0xc074, 0xc074, gcb_lv},
+	{0xc075, 0xc08f, gcb_lvt},
+	{0xc090, 0xc090, gcb_lv},
+	{0xc091, 0xc0ab, gcb_lvt},
+	{0xc0ac, 0xc0ac, gcb_lv},
+	{0xc0ad, 0xc0c7, gcb_lvt},
+	{0xc0c8, 0xc0c8, gcb_lv},
+	{0xc0c9, 0xc0e3, gcb_lvt},
+	{0xc0e4, 0xc0e4, gcb_lv},
+	{0xc0e5, 0xc0ff, gcb_lvt},
+	{0xc100, 0xc100, gcb_lv},
+	{0xc101, 0xc11b, gcb_lvt},
+	{0xc11c, 0xc11c, gcb_lv},
+	{0xc11d, 0xc137, gcb_lvt},
+	{0xc138, 0xc138, gcb_lv},
+	{0xc139, 0xc153, gcb_lvt},
+	{0xc154, 0xc154, gcb_lv},
+	{0xc155, 0xc16f, gcb_lvt},
+	{0xc170, 0xc170, gcb_lv},
+	{0xc171, 0xc18b, gcb_lvt},
+	{0xc18c, 0xc18c, gcb_lv},
+	{0xc18d, 0xc1a7, gcb_lvt},
+	{0xc1a8, 0xc1a8, gcb_lv},
+	{0xc1a9, 0xc1c3, gcb_lvt},
+	{0xc1c4, 0xc1c4, gcb_lv},
+	{0xc1c5, 0xc1df, gcb_lvt},
+	{0xc1e0, 0xc1e0, gcb_lv},
+	{0xc1e1, 0xc1fb, gcb_lvt},
+	{0xc1fc, 0xc1fc, gcb_lv},
+	{0xc1fd, 0xc217, gcb_lvt},
+	{0xc218, 0xc218, gcb_lv},
+	{0xc219, 0xc233, gcb_lvt},
+	{0xc234, 0xc234, gcb_lv},
+	{0xc235, 0xc24f, gcb_lvt},
+	{0xc250, 0xc250, gcb_lv},
+	{0xc251, 0xc26b, gcb_lvt},
+	{0xc26c, 0xc26c, gcb_lv},
+	{0xc26d, 0xc287, gcb_lvt},
+	{0xc288, 0xc288, gcb_lv},
+	{0xc289, 0xc2a3, gcb_lvt},
+	{0xc2a4, 0xc2a4, gcb_lv},
+	{0xc2a5, 0xc2bf, gcb_lvt},
+	{0xc2c0, 0xc2c0, gcb_lv},
+	{0xc2c1, 0xc2db, gcb_lvt},
+	{0xc2dc, 0xc2dc, gcb_lv},
+	{0xc2dd, 0xc2f7, gcb_lvt},
+	{0xc2f8, 0xc2f8, gcb_lv},
+	{0xc2f9, 0xc313, gcb_lvt},
+	{0xc314, 0xc314, gcb_lv},
+	{0xc315, 0xc32f, gcb_lvt},
+	{0xc330, 0xc330, gcb_lv},
+	{0xc331, 0xc34b, gcb_lvt},
+	{0xc34c, 0xc34c, gcb_lv},
+	{0xc34d, 0xc367, gcb_lvt},
+	{0xc368, 0xc368, gcb_lv},
+	{0xc369, 0xc383, gcb_lvt},
+	{0xc384, 0xc384, gcb_lv},
+	{0xc385, 0xc39f, gcb_lvt},
+	{0xc3a0, 0xc3a0, gcb_lv},
+	{0xc3a1, 0xc3bb, gcb_lvt},
+	{0xc3bc, 0xc3bc, gcb_lv},
+	{0xc3bd, 0xc3d7, gcb_lvt},
+	{0xc3d8, 0xc3d8, gcb_lv},
+	{0xc3d9, 0xc3f3, gcb_lvt},
+	{0xc3f4, 0xc3f4, gcb_lv},
+	{0xc3f5, 0xc40f, gcb_lvt},
+	{0xc410, 0xc410, gcb_lv},
+	{0xc411, 0xc42b, gcb_lvt},
+	{0xc42c, 0xc42c, gcb_lv},
+	{0xc42d, 0xc447, gcb_lvt},
+	{0xc448, 0xc448, gcb_lv},
+	{0xc449, 0xc463, gcb_lvt},
+	{0xc464, 0xc464, gcb_lv},
+	{0xc465, 0xc47f, gcb_lvt},
+	{0xc480, 0xc480, gcb_lv},
+	{0xc481, 0xc49b, gcb_lvt},
+	{0xc49c, 0xc49c, gcb_lv},
+	{0xc49d, 0xc4b7, gcb_lvt},
+	{0xc4b8, 0xc4b8, gcb_lv},
+	{0xc4b9, 0xc4d3, gcb_lvt},
+	{0xc4d4, 0xc4d4, gcb_lv},
+	{0xc4d5, 0xc4ef, gcb_lvt},
+	{0xc4f0, 0xc4f0, gcb_lv},
+	{0xc4f1, 0xc50b, gcb_lvt},
+	{0xc50c, 0xc50c, gcb_lv},
+	{0xc50d, 0xc527, gcb_lvt},
+	{0xc528, 0xc528, gcb_lv},
+	{0xc529, 0xc543, gcb_lvt},
+	{0xc544, 0xc544, gcb_lv},
+	{0xc545, 0xc55f, gcb_lvt},
+	{0xc560, 0xc560, gcb_lv},
+	{0xc561, 0xc57b, gcb_lvt},
+	{0xc57c, 0xc57c, gcb_lv},
+	{0xc57d, 0xc597, gcb_lvt},
+	{0xc598, 0xc598, gcb_lv},
+	{0xc599, 0xc5b3, gcb_lvt},
+	{0xc5b4, 0xc5b4, gcb_lv},
+	{0xc5b5, 0xc5cf, gcb_lvt},
+	{0xc5d0, 0xc5d0, gcb_lv},
+	{0xc5d1, 0xc5eb, gcb_lvt},
+	{0xc5ec, 0xc5ec, gcb_lv},
+	{0xc5ed, 0xc607, gcb_lvt},
+	{0xc608, 0xc608, gcb_lv},
+	{0xc609, 0xc623, gcb_lvt},
+	{0xc624, 0xc624, gcb_lv},
+	{0xc625, 0xc63f, gcb_lvt},
+	{0xc640, 0xc640, gcb_lv},
+	{0xc641, 0xc65b, gcb_lvt},
+	{0xc65c, 0xc65c, gcb_lv},
+	{0xc65d, 0xc677, gcb_lvt},
+	{0xc678, 0xc678, gcb_lv},
+	{0xc679, 0xc693, gcb_lvt},
+	{0xc694, 0xc694, gcb_lv},
+	{0xc695, 0xc6af, gcb_lvt},
+	{0xc6b0, 0xc6b0, gcb_lv},
+	{0xc6b1, 0xc6cb, gcb_lvt},
+	{0xc6cc, 0xc6cc, gcb_lv},
+	{0xc6cd, 0xc6e7, gcb_lvt},
+	{0xc6e8, 0xc6e8, gcb_lv},
+	{0xc6e9, 0xc703, gcb_lvt},
+	{0xc704, 0xc704, gcb_lv},
+	{0xc705, 0xc71f, gcb_lvt},
+	{0xc720, 0xc720, gcb_lv},
+	{0xc721, 0xc73b, gcb_lvt},
+	{0xc73c, 0xc73c, gcb_lv},
+	{0xc73d, 0xc757, gcb_lvt},
+	{0xc758, 0xc758, gcb_lv},
+	{0xc759, 0xc773, gcb_lvt},
+	{0xc774, 0xc774, gcb_lv},
+	{0xc775, 0xc78f, gcb_lvt},
+	{0xc790, 0xc790, gcb_lv},
+	{0xc791, 0xc7ab, gcb_lvt},
+	{0xc7ac, 0xc7ac, gcb_lv},
+	{0xc7ad, 0xc7c7, gcb_lvt},
+	{0xc7c8, 0xc7c8, gcb_lv},
+	{0xc7c9, 0xc7e3, gcb_lvt},
+	{0xc7e4, 0xc7e4, gcb_lv},
+	{0xc7e5, 0xc7ff, gcb_lvt},
+	{0xc800, 0xc800, gcb_lv},
+	{0xc801, 0xc81b, gcb_lvt},
+	{0xc81c, 0xc81c, gcb_lv},
+	{0xc81d, 0xc837, gcb_lvt},
+	{0xc838, 0xc838, gcb_lv},
+	{0xc839, 0xc853, gcb_lvt},
+	{0xc854, 0xc854, gcb_lv},
+	{0xc855, 0xc86f, gcb_lvt},
+	{0xc870, 0xc870, gcb_lv},
+	{0xc871, 0xc88b, gcb_lvt},
+	{0xc88c, 0xc88c, gcb_lv},
+	{0xc88d, 0xc8a7, gcb_lvt},
+	{0xc8a8, 0xc8a8, gcb_lv},
+	{0xc8a9, 0xc8c3, gcb_lvt},
+	{0xc8c4, 0xc8c4, gcb_lv},
+	{0xc8c5, 0xc8df, gcb_lvt},
+	{0xc8e0, 0xc8e0, gcb_lv},
+	{0xc8e1, 0xc8fb, gcb_lvt},
+	{0xc8fc, 0xc8fc, gcb_lv},
+	{0xc8fd, 0xc917, gcb_lvt},
+	{0xc918, 0xc918, gcb_lv},
+	{0xc919, 0xc933, gcb_lvt},
+	{0xc934, 0xc934, gcb_lv},
+	{0xc935, 0xc94f, gcb_lvt},
+	{0xc950, 0xc950, gcb_lv},
+	{0xc951, 0xc96b, gcb_lvt},
+	{0xc96c, 0xc96c, gcb_lv},
+	{0xc96d, 0xc987, gcb_lvt},
+	{0xc988, 0xc988, gcb_lv},
+	{0xc989, 0xc9a3, gcb_lvt},
+	{0xc9a4, 0xc9a4, gcb_lv},
+	{0xc9a5, 0xc9bf, gcb_lvt},
+	{0xc9c0, 0xc9c0, gcb_lv},
+	{0xc9c1, 0xc9db, gcb_lvt},
+	{0xc9dc, 0xc9dc, gcb_lv},
+	{0xc9dd, 0xc9f7, gcb_lvt},
+	{0xc9f8, 0xc9f8, gcb_lv},
+	{0xc9f9, 0xca13, gcb_lvt},
+	{0xca14, 0xca14, gcb_lv},
+	{0xca15, 0xca2f, gcb_lvt},
+	{0xca30, 0xca30, gcb_lv},
+	{0xca31, 0xca4b, gcb_lvt},
+	{0xca4c, 0xca4c, gcb_lv},
+	{0xca4d, 0xca67, gcb_lvt},
+	{0xca68, 0xca68, gcb_lv},
+	{0xca69, 0xca83, gcb_lvt},
+	{0xca84, 0xca84, gcb_lv},
+	{0xca85, 0xca9f, gcb_lvt},
+	{0xcaa0, 0xcaa0, gcb_lv},
+	{0xcaa1, 0xcabb, gcb_lvt},
+	{0xcabc, 0xcabc, gcb_lv},
+	{0xcabd, 0xcad7, gcb_lvt},
+	{0xcad8, 0xcad8, gcb_lv},
+	{0xcad9, 0xcaf3, gcb_lvt},
+	{0xcaf4, 0xcaf4, gcb_lv},
+	{0xcaf5, 0xcb0f, gcb_lvt},
+	{0xcb10, 0xcb10, gcb_lv},
+	{0xcb11, 0xcb2b, gcb_lvt},
+	{0xcb2c, 0xcb2c, gcb_lv},
+	{0xcb2d, 0xcb47, gcb_lvt},
+	{0xcb48, 0xcb48, gcb_lv},
+	{0xcb49, 0xcb63, gcb_lvt},
+	{0xcb64, 0xcb64, gcb_lv},
+	{0xcb65, 0xcb7f, gcb_lvt},
+	{0xcb80, 0xcb80, gcb_lv},
+	{0xcb81, 0xcb9b, gcb_lvt},
+	{0xcb9c, 0xcb9c, gcb_lv},
+	{0xcb9d, 0xcbb7, gcb_lvt},
+	{0xcbb8, 0xcbb8, gcb_lv},
+	{0xcbb9, 0xcbd3, gcb_lvt},
+	{0xcbd4, 0xcbd4, gcb_lv},
+	{0xcbd5, 0xcbef, gcb_lvt},
+	{0xcbf0, 0xcbf0, gcb_lv},
+	{0xcbf1, 0xcc0b, gcb_lvt},
+	{0xcc0c, 0xcc0c, gcb_lv},
+	{0xcc0d, 0xcc27, gcb_lvt},
+	{0xcc28, 0xcc28, gcb_lv},
+	{0xcc29, 0xcc43, gcb_lvt},
+	{0xcc44, 0xcc44, gcb_lv},
+	{0xcc45, 0xcc5f, gcb_lvt},
+	{0xcc60, 0xcc60, gcb_lv},
+	{0xcc61, 0xcc7b, gcb_lvt},
+	{0xcc7c, 0xcc7c, gcb_lv},
+	{0xcc7d, 0xcc97, gcb_lvt},
+	{0xcc98, 0xcc98, gcb_lv},
+	{0xcc99, 0xccb3, gcb_lvt},
+	{0xccb4, 0xccb4, gcb_lv},
+	{0xccb5, 0xcccf, gcb_lvt},
+	{0xccd0, 0xccd0, gcb_lv},
+	{0xccd1, 0xcceb, gcb_lvt},
+	{0xccec, 0xccec, gcb_lv},
+	{0xcced, 0xcd07, gcb_lvt},
+	{0xcd08, 0xcd08, gcb_lv},
+	{0xcd09, 0xcd23, gcb_lvt},
+	{0xcd24, 0xcd24, gcb_lv},
+	{0xcd25, 0xcd3f, gcb_lvt},
+	{0xcd40, 0xcd40, gcb_lv},
+	{0xcd41, 0xcd5b, gcb_lvt},
+	{0xcd5c, 0xcd5c, gcb_lv},
+	{0xcd5d, 0xcd77, gcb_lvt},
+	{0xcd78, 0xcd78, gcb_lv},
+	{0xcd79, 0xcd93, gcb_lvt},
+	{0xcd94, 0xcd94, gcb_lv},
+	{0xcd95, 0xcdaf, gcb_lvt},
+	{0xcdb0, 0xcdb0, gcb_lv},
+	{0xcdb1, 0xcdcb, gcb_lvt},
+	{0xcdcc, 0xcdcc, gcb_lv},
+	{0xcdcd, 0xcde7, gcb_lvt},
+	{0xcde8, 0xcde8, gcb_lv},
+	{0xcde9, 0xce03, gcb_lvt},
+	{0xce04, 0xce04, gcb_lv},
+	{0xce05, 0xce1f, gcb_lvt},
+	{0xce20, 0xce20, gcb_lv},
+	{0xce21, 0xce3b, gcb_lvt},
+	{0xce3c, 0xce3c, gcb_lv},
+	{0xce3d, 0xce57, gcb_lvt},
+	{0xce58, 0xce58, gcb_lv},
+	{0xce59, 0xce73, gcb_lvt},
+	{0xce74, 0xce74, gcb_lv},
+	{0xce75, 0xce8f, gcb_lvt},
+	{0xce90, 0xce90, gcb_lv},
+	{0xce91, 0xceab, gcb_lvt},
+	{0xceac, 0xceac, gcb_lv},
+	{0xcead, 0xcec7, gcb_lvt},
+	{0xcec8, 0xcec8, gcb_lv},
+	{0xcec9, 0xcee3, gcb_lvt},
+	{0xcee4, 0xcee4, gcb_lv},
+	{0xcee5, 0xceff, gcb_lvt},
+	{0xcf00, 0xcf00, gcb_lv},
+	{0xcf01, 0xcf1b, gcb_lvt},
+	{0xcf1c, 0xcf1c, gcb_lv},
+	{0xcf1d, 0xcf37, gcb_lvt},
+	{0xcf38, 0xcf38, gcb_lv},
+	{0xcf39, 0xcf53, gcb_lvt},
+	{0xcf54, 0xcf54, gcb_lv},
+	{0xcf55, 0xcf6f, gcb_lvt},
+	{0xcf70, 0xcf70, gcb_lv},
+	{0xcf71, 0xcf8b, gcb_lvt},
+	{0xcf8c, 0xcf8c, gcb_lv},
+	{0xcf8d, 0xcfa7, gcb_lvt},
+	{0xcfa8, 0xcfa8, gcb_lv},
+	{0xcfa9, 0xcfc3, gcb_lvt},
+	{0xcfc4, 0xcfc4, gcb_lv},
+	{0xcfc5, 0xcfdf, gcb_lvt},
+	{0xcfe0, 0xcfe0, gcb_lv},
+	{0xcfe1, 0xcffb, gcb_lvt},
+	{0xcffc, 0xcffc, gcb_lv},
+	{0xcffd, 0xd017, gcb_lvt},
+	{0xd018, 0xd018, gcb_lv},
+	{0xd019, 0xd033, gcb_lvt},
+	{0xd034, 0xd034, gcb_lv},
+	{0xd035, 0xd04f, gcb_lvt},
+	{0xd050, 0xd050, gcb_lv},
+	{0xd051, 0xd06b, gcb_lvt},
+	{0xd06c, 0xd06c, gcb_lv},
+	{0xd06d, 0xd087, gcb_lvt},
+	{0xd088, 0xd088, gcb_lv},
+	{0xd089, 0xd0a3, gcb_lvt},
+	{0xd0a4, 0xd0a4, gcb_lv},
+	{0xd0a5, 0xd0bf, gcb_lvt},
+	{0xd0c0, 0xd0c0, gcb_lv},
+	{0xd0c1, 0xd0db, gcb_lvt},
+	{0xd0dc, 0xd0dc, gcb_lv},
+	{0xd0dd, 0xd0f7, gcb_lvt},
+	{0xd0f8, 0xd0f8, gcb_lv},
+	{0xd0f9, 0xd113, gcb_lvt},
+	{0xd114, 0xd114, gcb_lv},
+	{0xd115, 0xd12f, gcb_lvt},
+	{0xd130, 0xd130, gcb_lv},
+	{0xd131, 0xd14b, gcb_lvt},
+	{0xd14c, 0xd14c, gcb_lv},
+	{0xd14d, 0xd167, gcb_lvt},
+	{0xd168, 0xd168, gcb_lv},
+	{0xd169, 0xd183, gcb_lvt},
+	{0xd184, 0xd184, gcb_lv},
+	{0xd185, 0xd19f, gcb_lvt},
+	{0xd1a0, 0xd1a0, gcb_lv},
+	{0xd1a1, 0xd1bb, gcb_lvt},
+	{0xd1bc, 0xd1bc, gcb_lv},
+	{0xd1bd, 0xd1d7, gcb_lvt},
+	{0xd1d8, 0xd1d8, gcb_lv},
+	{0xd1d9, 0xd1f3, gcb_lvt},
+	{0xd1f4, 0xd1f4, gcb_lv},
+	{0xd1f5, 0xd20f, gcb_lvt},
+	{0xd210, 0xd210, gcb_lv},
+	{0xd211, 0xd22b, gcb_lvt},
+	{0xd22c, 0xd22c, gcb_lv},
+	{0xd22d, 0xd247, gcb_lvt},
+	{0xd248, 0xd248, gcb_lv},
+	{0xd249, 0xd263, gcb_lvt},
+	{0xd264, 0xd264, gcb_lv},
+	{0xd265, 0xd27f, gcb_lvt},
+	{0xd280, 0xd280, gcb_lv},
+	{0xd281, 0xd29b, gcb_lvt},
+	{0xd29c, 0xd29c, gcb_lv},
+	{0xd29d, 0xd2b7, gcb_lvt},
+	{0xd2b8, 0xd2b8, gcb_lv},
+	{0xd2b9, 0xd2d3, gcb_lvt},
+	{0xd2d4, 0xd2d4, gcb_lv},
+	{0xd2d5, 0xd2ef, gcb_lvt},
+	{0xd2f0, 0xd2f0, gcb_lv},
+	{0xd2f1, 0xd30b, gcb_lvt},
+	{0xd30c, 0xd30c, gcb_lv},
+	{0xd30d, 0xd327, gcb_lvt},
+	{0xd328, 0xd328, gcb_lv},
+	{0xd329, 0xd343, gcb_lvt},
+	{0xd344, 0xd344, gcb_lv},
+	{0xd345, 0xd35f, gcb_lvt},
+	{0xd360, 0xd360, gcb_lv},
+	{0xd361, 0xd37b, gcb_lvt},
+	{0xd37c, 0xd37c, gcb_lv},
+	{0xd37d, 0xd397, gcb_lvt},
+	{0xd398, 0xd398, gcb_lv},
+	{0xd399, 0xd3b3, gcb_lvt},
+	{0xd3b4, 0xd3b4, gcb_lv},
+	{0xd3b5, 0xd3cf, gcb_lvt},
+	{0xd3d0, 0xd3d0, gcb_lv},
+	{0xd3d1, 0xd3eb, gcb_lvt},
+	{0xd3ec, 0xd3ec, gcb_lv},
+	{0xd3ed, 0xd407, gcb_lvt},
+	{0xd408, 0xd408, gcb_lv},
+	{0xd409, 0xd423, gcb_lvt},
+	{0xd424, 0xd424, gcb_lv},
+	{0xd425, 0xd43f, gcb_lvt},
+	{0xd440, 0xd440, gcb_lv},
+	{0xd441, 0xd45b, gcb_lvt},
+	{0xd45c, 0xd45c, gcb_lv},
+	{0xd45d, 0xd477, gcb_lvt},
+	{0xd478, 0xd478, gcb_lv},
+	{0xd479, 0xd493, gcb_lvt},
+	{0xd494, 0xd494, gcb_lv},
+	{0xd495, 0xd4af, gcb_lvt},
+	{0xd4b0, 0xd4b0, gcb_lv},
+	{0xd4b1, 0xd4cb, gcb_lvt},
+	{0xd4cc, 0xd4cc, gcb_lv},
+	{0xd4cd, 0xd4e7, gcb_lvt},
+	{0xd4e8, 0xd4e8, gcb_lv},
+	{0xd4e9, 0xd503, gcb_lvt},
+	{0xd504, 0xd504, gcb_lv},
+	{0xd505, 0xd51f, gcb_lvt},
+	{0xd520, 0xd520, gcb_lv},
+	{0xd521, 0xd53b, gcb_lvt},
+	{0xd53c, 0xd53c, gcb_lv},
+	{0xd53d, 0xd557, gcb_lvt},
+	{0xd558, 0xd558, gcb_lv},
+	{0xd559, 0xd573, gcb_lvt},
+	{0xd574, 0xd574, gcb_lv},
+	{0xd575, 0xd58f, gcb_lvt},
+	{0xd590, 0xd590, gcb_lv},
+	{0xd591, 0xd5ab, gcb_lvt},
+	{0xd5ac, 0xd5ac, gcb_lv},
+	{0xd5ad, 0xd5c7, gcb_lvt},
+	{0xd5c8, 0xd5c8, gcb_lv},
+	{0xd5c9, 0xd5e3, gcb_lvt},
+	{0xd5e4, 0xd5e4, gcb_lv},
+	{0xd5e5, 0xd5ff, gcb_lvt},
+	{0xd600, 0xd600, gcb_lv},
+	{0xd601, 0xd61b, gcb_lvt},
+	{0xd61c, 0xd61c, gcb_lv},
+	{0xd61d, 0xd637, gcb_lvt},
+	{0xd638, 0xd638, gcb_lv},
+	{0xd639, 0xd653, gcb_lvt},
+	{0xd654, 0xd654, gcb_lv},
+	{0xd655, 0xd66f, gcb_lvt},
+	{0xd670, 0xd670, gcb_lv},
+	{0xd671, 0xd68b, gcb_lvt},
+	{0xd68c, 0xd68c, gcb_lv},
+	{0xd68d, 0xd6a7, gcb_lvt},
+	{0xd6a8, 0xd6a8, gcb_lv},
+	{0xd6a9, 0xd6c3, gcb_lvt},
+	{0xd6c4, 0xd6c4, gcb_lv},
+	{0xd6c5, 0xd6df, gcb_lvt},
+	{0xd6e0, 0xd6e0, gcb_lv},
+	{0xd6e1, 0xd6fb, gcb_lvt},
+	{0xd6fc, 0xd6fc, gcb_lv},
+	{0xd6fd, 0xd717, gcb_lvt},
+	{0xd718, 0xd718, gcb_lv},
+	{0xd719, 0xd733, gcb_lvt},
+	{0xd734, 0xd734, gcb_lv},
+	{0xd735, 0xd74f, gcb_lvt},
+	{0xd750, 0xd750, gcb_lv},
+	{0xd751, 0xd76b, gcb_lvt},
+	{0xd76c, 0xd76c, gcb_lv},
+	{0xd76d, 0xd787, gcb_lvt},
+	{0xd788, 0xd788, gcb_lv},
+	{0xd789, 0xd7a3, gcb_lvt},
+	{0xd7b0, 0xd7c6, gcb_v},
+	{0xd7cb, 0xd7fb, gcb_t},
+	{0xfb1e, 0xfb1e, gcb_extend},
+	{0xfe00, 0xfe0f, gcb_extend},
+	{0xfe20, 0xfe2f, gcb_extend},
+	{0xfeff, 0xfeff, gcb_control},
+	{0xff9e, 0xff9f, gcb_extend},
+	{0xfff0, 0xfffb, gcb_control},
+	{0x101fd, 0x101fd, gcb_extend},
+	{0x102e0, 0x102e0, gcb_extend},
+	{0x10376, 0x1037a, gcb_extend},
+	{0x10a01, 0x10a03, gcb_extend},
+	{0x10a05, 0x10a06, gcb_extend},
+	{0x10a0c, 0x10a0f, gcb_extend},
+	{0x10a38, 0x10a3a, gcb_extend},
+	{0x10a3f, 0x10a3f, gcb_extend},
+	{0x10ae5, 0x10ae6, gcb_extend},
+	{0x10d24, 0x10d27, gcb_extend},
+	{0x10d69, 0x10d6d, gcb_extend},
+	{0x10eab, 0x10eac, gcb_extend},
+	{0x10efa, 0x10eff, gcb_extend},
+	{0x10f46, 0x10f50, gcb_extend},
+	{0x10f82, 0x10f85, gcb_extend},
+	{0x11000, 0x11000, gcb_spacingmark},
+	{0x11001, 0x11001, gcb_extend},
+	{0x11002, 0x11002, gcb_spacingmark},
+	{0x11038, 0x11046, gcb_extend},
+	{0x11070, 0x11070, gcb_extend},
+	{0x11073, 0x11074, gcb_extend},
+	{0x1107f, 0x11081, gcb_extend},
+	{0x11082, 0x11082, gcb_spacingmark},
+	{0x110b0, 0x110b2, gcb_spacingmark},
+	{0x110b3, 0x110b6, gcb_extend},
+	{0x110b7, 0x110b8, gcb_spacingmark},
+	{0x110b9, 0x110ba, gcb_extend},
+	{0x110bd, 0x110bd, gcb_prepend},
+	{0x110c2, 0x110c2, gcb_extend},
+	{0x110cd, 0x110cd, gcb_prepend},
+	{0x11100, 0x11102, gcb_extend},
+	{0x11127, 0x1112b, gcb_extend},
+	{0x1112c, 0x1112c, gcb_spacingmark},
+	{0x1112d, 0x11134, gcb_extend},
+	{0x11145, 0x11146, gcb_spacingmark},
+	{0x11173, 0x11173, gcb_extend},
+	{0x11180, 0x11181, gcb_extend},
+	{0x11182, 0x11182, gcb_spacingmark},
+	{0x111b3, 0x111b5, gcb_spacingmark},
+	{0x111b6, 0x111be, gcb_extend},
+	{0x111bf, 0x111bf, gcb_spacingmark},
+	{0x111c0, 0x111c0, gcb_extend},
+	{0x111c2, 0x111c3, gcb_prepend},
+	{0x111c9, 0x111cc, gcb_extend},
+	{0x111ce, 0x111ce, gcb_spacingmark},
+	{0x111cf, 0x111cf, gcb_extend},
+	{0x1122c, 0x1122e, gcb_spacingmark},
+	{0x1122f, 0x11231, gcb_extend},
+	{0x11232, 0x11233, gcb_spacingmark},
+	{0x11234, 0x11237, gcb_extend},
+	{0x1123e, 0x1123e, gcb_extend},
+	{0x11241, 0x11241, gcb_extend},
+	{0x112df, 0x112df, gcb_extend},
+	{0x112e0, 0x112e2, gcb_spacingmark},
+	{0x112e3, 0x112ea, gcb_extend},
+	{0x11300, 0x11301, gcb_extend},
+	{0x11302, 0x11303, gcb_spacingmark},
+	{0x1133b, 0x1133c, gcb_extend},
+	{0x1133e, 0x1133e, gcb_extend},
+	{0x1133f, 0x1133f, gcb_spacingmark},
+	{0x11340, 0x11340, gcb_extend},
+	{0x11341, 0x11344, gcb_spacingmark},
+	{0x11347, 0x11348, gcb_spacingmark},
+	{0x1134b, 0x1134c, gcb_spacingmark},
+	{0x1134d, 0x1134d, gcb_extend},
+	{0x11357, 0x11357, gcb_extend},
+	{0x11362, 0x11363, gcb_spacingmark},
+	{0x11366, 0x1136c, gcb_extend},
+	{0x11370, 0x11374, gcb_extend},
+	{0x113b8, 0x113b8, gcb_extend},
+	{0x113b9, 0x113ba, gcb_spacingmark},
+	{0x113bb, 0x113c0, gcb_extend},
+	{0x113c2, 0x113c2, gcb_extend},
+	{0x113c5, 0x113c5, gcb_extend},
+	{0x113c7, 0x113c9, gcb_extend},
+	{0x113ca, 0x113ca, gcb_spacingmark},
+	{0x113cc, 0x113cd, gcb_spacingmark},
+	{0x113ce, 0x113d0, gcb_extend},
+	{0x113d1, 0x113d1, gcb_prepend},
+	{0x113d2, 0x113d2, gcb_extend},
+	{0x113e1, 0x113e2, gcb_extend},
+	{0x11435, 0x11437, gcb_spacingmark},
+	{0x11438, 0x1143f, gcb_extend},
+	{0x11440, 0x11441, gcb_spacingmark},
+	{0x11442, 0x11444, gcb_extend},
+	{0x11445, 0x11445, gcb_spacingmark},
+	{0x11446, 0x11446, gcb_extend},
+	{0x1145e, 0x1145e, gcb_extend},
+	{0x114b0, 0x114b0, gcb_extend},
+	{0x114b1, 0x114b2, gcb_spacingmark},
+	{0x114b3, 0x114b8, gcb_extend},
+	{0x114b9, 0x114b9, gcb_spacingmark},
+	{0x114ba, 0x114ba, gcb_extend},
+	{0x114bb, 0x114bc, gcb_spacingmark},
+	{0x114bd, 0x114bd, gcb_extend},
+	{0x114be, 0x114be, gcb_spacingmark},
+	{0x114bf, 0x114c0, gcb_extend},
+	{0x114c1, 0x114c1, gcb_spacingmark},
+	{0x114c2, 0x114c3, gcb_extend},
+	{0x115af, 0x115af, gcb_extend},
+	{0x115b0, 0x115b1, gcb_spacingmark},
+	{0x115b2, 0x115b5, gcb_extend},
+	{0x115b8, 0x115bb, gcb_spacingmark},
+	{0x115bc, 0x115bd, gcb_extend},
+	{0x115be, 0x115be, gcb_spacingmark},
+	{0x115bf, 0x115c0, gcb_extend},
+	{0x115dc, 0x115dd, gcb_extend},
+	{0x11630, 0x11632, gcb_spacingmark},
+	{0x11633, 0x1163a, gcb_extend},
+	{0x1163b, 0x1163c, gcb_spacingmark},
+	{0x1163d, 0x1163d, gcb_extend},
+	{0x1163e, 0x1163e, gcb_spacingmark},
+	{0x1163f, 0x11640, gcb_extend},
+	{0x116ab, 0x116ab, gcb_extend},
+	{0x116ac, 0x116ac, gcb_spacingmark},
+	{0x116ad, 0x116ad, gcb_extend},
+	{0x116ae, 0x116af, gcb_spacingmark},
+	{0x116b0, 0x116b7, gcb_extend},
+	{0x1171d, 0x1171d, gcb_extend},
+	{0x1171e, 0x1171e, gcb_spacingmark},
+	{0x1171f, 0x1171f, gcb_extend},
+	{0x11722, 0x11725, gcb_extend},
+	{0x11726, 0x11726, gcb_spacingmark},
+	{0x11727, 0x1172b, gcb_extend},
+	{0x1182c, 0x1182e, gcb_spacingmark},
+	{0x1182f, 0x11837, gcb_extend},
+	{0x11838, 0x11838, gcb_spacingmark},
+	{0x11839, 0x1183a, gcb_extend},
+	{0x11930, 0x11930, gcb_extend},
+	{0x11931, 0x11935, gcb_spacingmark},
+	{0x11937, 0x11938, gcb_spacingmark},
+	{0x1193b, 0x1193e, gcb_extend},
+	{0x1193f, 0x1193f, gcb_prepend},
+	{0x11940, 0x11940, gcb_spacingmark},
+	{0x11941, 0x11941, gcb_prepend},
+	{0x11942, 0x11942, gcb_spacingmark},
+	{0x11943, 0x11943, gcb_extend},
+	{0x119d1, 0x119d3, gcb_spacingmark},
+	{0x119d4, 0x119d7, gcb_extend},
+	{0x119da, 0x119db, gcb_extend},
+	{0x119dc, 0x119df, gcb_spacingmark},
+	{0x119e0, 0x119e0, gcb_extend},
+	{0x119e4, 0x119e4, gcb_spacingmark},
+	{0x11a01, 0x11a0a, gcb_extend},
+	{0x11a33, 0x11a38, gcb_extend},
+	{0x11a39, 0x11a39, gcb_spacingmark},
+	{0x11a3b, 0x11a3e, gcb_extend},
+	{0x11a47, 0x11a47, gcb_extend},
+	{0x11a51, 0x11a56, gcb_extend},
+	{0x11a57, 0x11a58, gcb_spacingmark},
+	{0x11a59, 0x11a5b, gcb_extend},
+	{0x11a84, 0x11a89, gcb_prepend},
+	{0x11a8a, 0x11a96, gcb_extend},
+	{0x11a97, 0x11a97, gcb_spacingmark},
+	{0x11a98, 0x11a99, gcb_extend},
+	{0x11b60, 0x11b60, gcb_extend},
+	{0x11b61, 0x11b61, gcb_spacingmark},
+	{0x11b62, 0x11b64, gcb_extend},
+	{0x11b65, 0x11b65, gcb_spacingmark},
+	{0x11b66, 0x11b66, gcb_extend},
+	{0x11b67, 0x11b67, gcb_spacingmark},
+	{0x11c2f, 0x11c2f, gcb_spacingmark},
+	{0x11c30, 0x11c36, gcb_extend},
+	{0x11c38, 0x11c3d, gcb_extend},
+	{0x11c3e, 0x11c3e, gcb_spacingmark},
+	{0x11c3f, 0x11c3f, gcb_extend},
+	{0x11c92, 0x11ca7, gcb_extend},
+	{0x11ca9, 0x11ca9, gcb_spacingmark},
+	{0x11caa, 0x11cb0, gcb_extend},
+	{0x11cb1, 0x11cb1, gcb_spacingmark},
+	{0x11cb2, 0x11cb3, gcb_extend},
+	{0x11cb4, 0x11cb4, gcb_spacingmark},
+	{0x11cb5, 0x11cb6, gcb_extend},
+	{0x11d31, 0x11d36, gcb_extend},
+	{0x11d3a, 0x11d3a, gcb_extend},
+	{0x11d3c, 0x11d3d, gcb_extend},
+	{0x11d3f, 0x11d45, gcb_extend},
+	{0x11d46, 0x11d46, gcb_prepend},
+	{0x11d47, 0x11d47, gcb_extend},
+	{0x11d8a, 0x11d8e, gcb_spacingmark},
+	{0x11d90, 0x11d91, gcb_extend},
+	{0x11d93, 0x11d94, gcb_spacingmark},
+	{0x11d95, 0x11d95, gcb_extend},
+	{0x11d96, 0x11d96, gcb_spacingmark},
+	{0x11d97, 0x11d97, gcb_extend},
+	{0x11ef3, 0x11ef4, gcb_extend},
+	{0x11ef5, 0x11ef6, gcb_spacingmark},
+	{0x11f00, 0x11f01, gcb_extend},
+	{0x11f02, 0x11f02, gcb_prepend},
+	{0x11f03, 0x11f03, gcb_spacingmark},
+	{0x11f34, 0x11f35, gcb_spacingmark},
+	{0x11f36, 0x11f3a, gcb_extend},
+	{0x11f3e, 0x11f3f, gcb_spacingmark},
+	{0x11f40, 0x11f42, gcb_extend},
+	{0x11f5a, 0x11f5a, gcb_extend},
+	{0x13430, 0x1343f, gcb_control},
+	{0x13440, 0x13440, gcb_extend},
+	{0x13447, 0x13455, gcb_extend},
+	{0x1611e, 0x16129, gcb_extend},
+	{0x1612a, 0x1612c, gcb_spacingmark},
+	{0x1612d, 0x1612f, gcb_extend},
+	{0x16af0, 0x16af4, gcb_extend},
+	{0x16b30, 0x16b36, gcb_extend},
+	{0x16d63, 0x16d63, gcb_v},
+	{0x16d67, 0x16d6a, gcb_v},
+	{0x16f4f, 0x16f4f, gcb_extend},
+	{0x16f51, 0x16f87, gcb_spacingmark},
+	{0x16f8f, 0x16f92, gcb_extend},
+	{0x16fe4, 0x16fe4, gcb_extend},
+	{0x16ff0, 0x16ff1, gcb_extend},
+	{0x1bc9d, 0x1bc9e, gcb_extend},
+	{0x1bca0, 0x1bca3, gcb_control},
+	{0x1cf00, 0x1cf2d, gcb_extend},
+	{0x1cf30, 0x1cf46, gcb_extend},
+	{0x1d165, 0x1d169, gcb_extend},
+	{0x1d16d, 0x1d172, gcb_extend},
+	{0x1d173, 0x1d17a, gcb_control},
+	{0x1d17b, 0x1d182, gcb_extend},
+	{0x1d185, 0x1d18b, gcb_extend},
+	{0x1d1aa, 0x1d1ad, gcb_extend},
+	{0x1d242, 0x1d244, gcb_extend},
+	{0x1da00, 0x1da36, gcb_extend},
+	{0x1da3b, 0x1da6c, gcb_extend},
+	{0x1da75, 0x1da75, gcb_extend},
+	{0x1da84, 0x1da84, gcb_extend},
+	{0x1da9b, 0x1da9f, gcb_extend},
+	{0x1daa1, 0x1daaf, gcb_extend},
+	{0x1e000, 0x1e006, gcb_extend},
+	{0x1e008, 0x1e018, gcb_extend},
+	{0x1e01b, 0x1e021, gcb_extend},
+	{0x1e023, 0x1e024, gcb_extend},
+	{0x1e026, 0x1e02a, gcb_extend},
+	{0x1e08f, 0x1e08f, gcb_extend},
+	{0x1e130, 0x1e136, gcb_extend},
+	{0x1e2ae, 0x1e2ae, gcb_extend},
+	{0x1e2ec, 0x1e2ef, gcb_extend},
+	{0x1e4ec, 0x1e4ef, gcb_extend},
+	{0x1e5ee, 0x1e5ef, gcb_extend},
+	{0x1e6e3, 0x1e6e3, gcb_extend},
+	{0x1e6e6, 0x1e6e6, gcb_extend},
+	{0x1e6ee, 0x1e6ef, gcb_extend},
+	{0x1e6f5, 0x1e6f5, gcb_extend},
+	{0x1e8d0, 0x1e8d6, gcb_extend},
+	{0x1e944, 0x1e94a, gcb_extend},
+	{0x1f1e6, 0x1f1ff, gcb_regional_indicator},
+	{0x1f3fb, 0x1f3ff, gcb_extend},
+	{0xe0000, 0xe001f, gcb_control},
+	{0xe0020, 0xe007f, gcb_extend},
+	{0xe0080, 0xe00ff, gcb_control},
+	{0xe0100, 0xe01ef, gcb_extend},
+	{0xe01f0, 0xe0fff, gcb_control},
+}
+
+// extended_pictographic holds the Extended_Pictographic code points of UTS #51.
+var extended_pictographic = []uintrange{
+	{0x00a9, 0x00a9},
+	{0x00ae, 0x00ae},
+	{0x203c, 0x203c},
+	{0x2049, 0x2049},
+	{0x2122, 0x2122},
+	{0x2139, 0x2139},
+	{0x2194, 0x2199},
+	{0x21a9, 0x21aa},
+	{0x231a, 0x231b},
+	{0x2328, 0x2328},
+	{0x23cf, 0x23cf},
+	{0x23e9, 0x23f3},
+	{0x23f8, 0x23fa},
+	{0x24c2, 0x24c2},
+	{0x25aa, 0x25ab},
+	{0x25b6, 0x25b6},
+	{0x25c0, 0x25c0},
+	{0x25fb, 0x25fe},
+	{0x2600, 0x2604},
+	{0x260e, 0x260e},
+	{0x2611, 0x2611},
+	{0x2614, 0x2615},
+	{0x2618, 0x2618},
+	{0x261d, 0x261d},
+	{0x2620, 0x2620},
+	{0x2622, 0x2623},
+	{0x2626, 0x2626},
+	{0x262a, 0x262a},
+	{0x262e, 0x262f},
+	{0x2638, 0x263a},
+	{0x2640, 0x2640},
+	{0x2642, 0x2642},
+	{0x2648, 0x2653},
+	{0x265f, 0x2660},
+	{0x2663, 0x2663},
+	{0x2665, 0x2666},
+	{0x2668, 0x2668},
+	{0x267b, 0x267b},
+	{0x267e, 0x267f},
+	{0x2692, 0x2697},
+	{0x2699, 0x2699},
+	{0x269b, 0x269c},
+	{0x26a0, 0x26a1},
+	{0x26a7, 0x26a7},
+	{0x26aa, 0x26ab},
+	{0x26b0, 0x26b1},
+	{0x26bd, 0x26be},
+	{0x26c4, 0x26c5},
+	{0x26c8, 0x26c8},
+	{0x26ce, 0x26cf},
+	{0x26d1, 0x26d1},
+	{0x26d3, 0x26d4},
+	{0x26e9, 0x26ea},
+	{0x26f0, 0x26f5},
+	{0x26f7, 0x26fa},
+	{0x26fd, 0x26fd},
+	{0x2702, 0x2702},
+	{0x2705, 0x2705},
+	{0x2708, 0x270d},
+	{0x270f, 0x270f},
+	{0x2712, 0x2712},
+	{0x2714, 0x2714},
+	{0x2716, 0x2716},
+	{0x271d, 0x271d},
+	{0x2721, 0x2721},
+	{0x2728, 0x2728},
+	{0x2733, 0x2734},
+	{0x2744, 0x2744},
+	{0x2747, 0x2747},
+	{0x274c, 0x274c},
+	{0x274e, 0x274e},
+	{0x2753, 0x2755},
+	{0x2757, 0x2757},
+	{0x2763, 0x2764},
+	{0x2795, 0x2797},
+	{0x27a1, 0x27a1},
+	{0x27b0, 0x27b0},
+	{0x27bf, 0x27bf},
+	{0x2934, 0x2935},
+	{0x2b05, 0x2b07},
+	{0x2b1b, 0x2b1c},
+	{0x2b50, 0x2b50},
+	{0x2b55, 0x2b55},
+	{0x3030, 0x3030},
+	{0x303d, 0x303d},
+	{0x3297, 0x3297},
+	{0x3299, 0x3299},
+	{0x1f004, 0x1f004},
+	{0x1f02c, 0x1f02f},
+	{0x1f094, 0x1f09f},
+	{0x1f0af, 0x1f0b0},
+	{0x1f0c0, 0x1f0c0},
+	{0x1f0cf, 0x1f0d0},
+	{0x1f0f6, 0x1f0ff},
+	{0x1f170, 0x1f171},
+	{0x1f17e, 0x1f17f},
+	{0x1f18e, 0x1f18e},
+	{0x1f191, 0x1f19a},
+	{0x1f1ae, 0x1f1e5},
+	{0x1f201, 0x1f20f},
+	{0x1f21a, 0x1f21a},
+	{0x1f22f, 0x1f22f},
+	{0x1f232, 0x1f23a},
+	{0x1f23c, 0x1f23f},
+	{0x1f249, 0x1f25f},
+	{0x1f266, 0x1f321},
+	{0x1f324, 0x1f393},
+	{0x1f396, 0x1f397},
+	{0x1f399, 0x1f39b},
+	{0x1f39e, 0x1f3f0},
+	{0x1f3f3, 0x1f3f5},
+	{0x1f3f7, 0x1f3fa},
+	{0x1f400, 0x1f4fd},
+	{0x1f4ff, 0x1f53d},
+	{0x1f549, 0x1f54e},
+	{0x1f550, 0x1f567},
+	{0x1f56f, 0x1f570},
+	{0x1f573, 0x1f57a},
+	{0x1f587, 0x1f587},
+	{0x1f58a, 0x1f58d},
+	{0x1f590, 0x1f590},
+	{0x1f595, 0x1f596},
+	{0x1f5a4, 0x1f5a5},
+	{0x1f5a8, 0x1f5a8},
+	{0x1f5b1, 0x1f5b2},
+	{0x1f5bc, 0x1f5bc},
+	{0x1f5c2, 0x1f5c4},
+	{0x1f5d1, 0x1f5d3},
+	{0x1f5dc, 0x1f5de},
+	{0x1f5e1, 0x1f5e1},
+	{0x1f5e3, 0x1f5e3},
+	{0x1f5e8, 0x1f5e8},
+	{0x1f5ef, 0x1f5ef},
+	{0x1f5f3, 0x1f5f3},
+	{0x1f5fa, 0x1f64f},
+	{0x1f680, 0x1f6c5},
+	{0x1f6cb, 0x1f6d2},
+	{0x1f6d5, 0x1f6e5},
+	{0x1f6e9, 0x1f6e9},
+	{0x1f6eb, 0x1f6f0},
+	{0x1f6f3, 0x1f6ff},
+	{0x1f7da, 0x1f7ff},
+	{0x1f80c, 0x1f80f},
+	{0x1f848, 0x1f84f},
+	{0x1f85a, 0x1f85f},
+	{0x1f888, 0x1f88f},
+	{0x1f8ae, 0x1f8af},
+	{0x1f8bc, 0x1f8bf},
+	{0x1f8c2, 0x1f8cf},
+	{0x1f8d9, 0x1f8ff},
+	{0x1f90c, 0x1f93a},
+	{0x1f93c, 0x1f945},
+	{0x1f947, 0x1f9ff},
+	{0x1fa58, 0x1fa5f},
+	{0x1fa6e, 0x1faff},
+	{0x1fc00, 0x1fffd},
+}
+
+// indic_conjunct_breaks holds the Indic_Conjunct_Break property of UAX #44 by
+// range of code points. Code points not listed are incb_none.
+var indic_conjunct_breaks = []incb_range{
+	{0x0300, 0x036f, incb_extend},
+	{0x0483, 0x0489, incb_extend},
+	{0x0591, 0x05bd, incb_extend},
+	{0x05bf, 0x05bf, incb_extend},
+	{0x05c1, 0x05c2, incb_extend},
+	{0x05c4, 0x05c5, incb_extend},
+	{0x05c7, 0x05c7, incb_extend},
+	{0x0610, 0x061a, incb_extend},
+	{0x064b, 0x065f, incb_extend},
+	{0x0670, 0x0670, incb_extend},
+	{0x06d6, 0x06dc, incb_extend},
+	{0x06df, 0x06e4, incb_extend},
+	{0x06e7, 0x06e8, incb_extend},
+	{0x06ea, 0x06ed, incb_extend},
+	{0x0711, 0x0711, incb_extend},
+	{0x0730, 0x074a, incb_extend},
+	{0x07a6, 0x07b0, incb_extend},
+	{0x07eb, 0x07f3, incb_extend},
+	{0x07fd, 0x07fd, incb_extend},
+	{0x0816, 0x0819, incb_extend},
+	{0x081b, 0x0823, incb_extend},
+	{0x0825, 0x0827, incb_extend},
+	{0x0829, 0x082d, incb_extend},
+	{0x0859, 0x085b, incb_extend},
+	{0x0897, 0x089f, incb_extend},
+	{0x08ca, 0x08e1, incb_extend},
+	{0x08e3, 0x0902, incb_extend},
+	{0x0915, 0x0939, incb_consonant},
+	{0x093a, 0x093a, incb_extend},
+	{0x093c, 0x093c, incb_extend},
+	{0x0941, 0x0948, incb_extend},
+	{0x094d, 0x094d, incb_linker},
+	{0x0951, 0x0957, incb_extend},
+	{0x0958, 0x095f, incb_consonant},
+	{0x0962, 0x0963, incb_extend},
+	{0x0978, 0x097f, incb_consonant},
+	{0x0981, 0x0981, incb_extend},
+	{0x0995, 0x09a8, incb_consonant},
+	{0x09aa, 0x09b0, incb_consonant},
+	{0x09b2, 0x09b2, incb_consonant},
+	{0x09b6, 0x09b9, incb_consonant},
+	{0x09bc, 0x09bc, incb_extend},
+	{0x09be, 0x09be, incb_extend},
+	{0x09c1, 0x09c4, incb_extend},
+	{0x09cd, 0x09cd, incb_linker},
+	{0x09d7, 0x09d7, incb_extend},
+	{0x09dc, 0x09dd, incb_consonant},
+	{0x09df, 0x09df, incb_consonant},
+	{0x09e2, 0x09e3, incb_extend},
+	{0x09f0, 0x09f1, incb_consonant},
+	{0x09fe, 0x09fe, incb_extend},
+	{0x0a01, 0x0a02, incb_extend},
+	{0x0a3c, 0x0a3c, incb_extend},
+	{0x0a41, 0x0a42, incb_extend},
+	{0x0a47, 0x0a48, incb_extend},
+	{0x0a4b, 0x0a4d, incb_extend},
+	{0x0a51, 0x0a51, incb_extend},
+	{0x0a70, 0x0a71, incb_extend},
+	{0x0a75, 0x0a75, incb_extend},
+	{0x0a81, 0x0a82, incb_extend},
+	{0x0a95, 0x0aa8, incb_consonant},
+	{0x0aaa, 0x0ab0, incb_consonant},
+	{0x0ab2, 0x0ab3, incb_consonant},
+	{0x0ab5, 0x0ab9, incb_consonant},
+	{0x0abc, 0x0abc, incb_extend},
+	{0x0ac1, 0x0ac5, incb_extend},
+	{0x0ac7, 0x0ac8, incb_extend},
+	{0x0acd, 0x0acd, incb_linker},
+	{0x0ae2, 0x0ae3, incb_extend},
+	{0x0af9, 0x0af9, incb_consonant},
+	{0x0afa, 0x0aff, incb_extend},
+	{0x0b01, 0x0b01, incb_extend},
+	{0x0b15, 0x0b28, incb_consonant},
+	{0x0b2a, 0x0b30, incb_consonant},
+	{0x0b32, 0x0b33, incb_consonant},
+	{0x0b35, 0x0b39, incb_consonant},
+	{0x0b3c, 0x0b3c, incb_extend},
+	{0x0b3e, 0x0b3f, incb_extend},
+	{0x0b41, 0x0b44, incb_extend},
+	{0x0b4d, 0x0b4d, incb_linker},
+	{0x0b55, 0x0b57, incb_extend},
+	{0x0b5c, 0x0b5d, incb_consonant},
+	{0x0b5f, 0x0b5f, incb_consonant},
+	{0x0b62, 0x0b63, incb_extend},
+	{0x0b71, 0x0b71, incb_consonant},
+	{0x0b82, 0x0b82, incb_extend},
+	{0x0bbe, 0x0bbe, incb_extend},
+	{0x0bc0, 0x0bc0, incb_extend},
+	{0x0bcd, 0x0bcd, incb_extend},
+	{0x0bd7, 0x0bd7, incb_extend},
+	{0x0c00, 0x0c00, incb_extend},
+	{0x0c04, 0x0c04, incb_extend},
+	{0x0c15, 0x0c28, incb_consonant},
+	{0x0c2a, 0x0c39, incb_consonant},
+	{0x0c3c, 0x0c3c, incb_extend},
+	{0x0c3e, 0x0c40, incb_extend},
+	{0x0c46, 0x0c48, incb_extend},
+	{0x0c4a, 0x0c4c, incb_extend},
+	{0x0c4d, 0x0c4d, incb_linker},
+	{0x0c55, 0x0c56, incb_extend},
+	{0x0c58, 0x0c5a, incb_consonant},
+	{0x0c62, 0x0c63, incb_extend},
+	{0x0c81, 0x0c81, incb_extend},
+	{0x0cbc, 0x0cbc, incb_extend},
+	{0x0cbf, 0x0cc0, incb_extend},
+	{0x0cc2, 0x0cc2, incb_extend},
+	{0x0cc6, 0x0cc8, incb_extend},
+	{0x0cca, 0x0ccd, incb_extend},
+	{0x0cd5, 0x0cd6, incb_extend},
+	{0x0ce2, 0x0ce3, incb_extend},
+	{0x0d00, 0x0d01, incb_extend},
+	{0x0d15, 0x0d3a, incb_consonant},
+	{0x0d3b, 0x0d3c, incb_extend},
+	{0x0d3e, 0x0d3e, incb_extend},
+	{0x0d41, 0x0d44, incb_extend},
+	{0x0d4d, 0x0d4d, incb_linker},
+	{0x0d57, 0x0d57, incb_extend},
+	{0x0d62, 0x0d63, incb_extend},
+	{0x0d81, 0x0d81, incb_extend},
+	{0x0dca, 0x0dca, incb_extend},
+	{0x0dcf, 0x0dcf, incb_extend},
+	{0x0dd2, 0x0dd4, incb_extend},
+	{0x0dd6, 0x0dd6, incb_extend},
+	{0x0ddf, 0x0ddf, incb_extend},
+	{0x0e31, 0x0e31, incb_extend},
+	{0x0e34, 0x0e3a, incb_extend},
+	{0x0e47, 0x0e4e, incb_extend},
+	{0x0eb1, 0x0eb1, incb_extend},
+	{0x0eb4, 0x0ebc, incb_extend},
+	{0x0ec8, 0x0ece, incb_extend},
+	{0x0f18, 0x0f19, incb_extend},
+	{0x0f35, 0x0f35, incb_extend},
+	{0x0f37, 0x0f37, incb_extend},
+	{0x0f39, 0x0f39, incb_extend},
+	{0x0f71, 0x0f7e, incb_extend},
+	{0x0f80, 0x0f84, incb_extend},
+	{0x0f86, 0x0f87, incb_extend},
+	{0x0f8d, 0x0f97, incb_extend},
+	{0x0f99, 0x0fbc, incb_extend},
+	{0x0fc6, 0x0fc6, incb_extend},
+	{0x1000, 0x102a, incb_consonant},
+	{0x102d, 0x1030, incb_extend},
+	{0x1032, 0x1037, incb_extend},
+	{0x1039, 0x1039, incb_linker},
+	{0x103a, 0x103a, incb_extend},
+	{0x103d, 0x103e, incb_extend},
+	{0x103f, 0x103f, incb_consonant},
+	{0x1050, 0x1055, incb_consonant},
+	{0x1058, 0x1059, incb_extend},
+	{0x105a, 0x105d, incb_consonant},
+	{0x105e, 0x1060, incb_extend},
+	{0x1061, 0x1061, incb_consonant},
+	{0x1065, 0x1066, incb_consonant},
+	{0x106e, 0x1070, incb_consonant},
+	{0x1071, 0x1074, incb_extend},
+	{0x1075, 0x1081, incb_consonant},
+	{0x1082, 0x1082, incb_extend},
+	{0x1085, 0x1086, incb_extend},
+	{0x108d, 0x108d, incb_extend},
+	{0x108e, 0x108e, incb_consonant},
+	{0x109d, 0x109d, incb_extend},
+	{0x135d, 0x135f, incb_extend},
+	{0x1712, 0x1715, incb_extend},
+	{0x1732, 0x1734, incb_extend},
+	{0x1752, 0x1753, incb_extend},
+	{0x1772, 0x1773, incb_extend},
+	{0x1780, 0x17b3, incb_consonant},
+	{0x17b4, 0x17b5, incb_extend},
+	{0x17b7, 0x17bd, incb_extend},
+	{0x17c6, 0x17c6, incb_extend},
+	{0x17c9, 0x17d1, incb_extend},
+	{0x17d2, 0x17d2, incb_linker},
+	{0x17d3, 0x17d3, incb_extend},
+	{0x17dd, 0x17dd, incb_extend},
+	{0x180b, 0x180d, incb_extend},
+	{0x180f, 0x180f, incb_extend},
+	{0x1885, 0x1886, incb_extend},
+	{0x18a9, 0x18a9, incb_extend},
+	{0x1920, 0x1922, incb_extend},
+	{0x1927, 0x1928, incb_extend},
+	{0x1932, 0x1932, incb_extend},
+	{0x1939, 0x193b, incb_extend},
+	{0x1a17, 0x1a18, incb_extend},
+	{0x1a1b, 0x1a1b, incb_extend},
+	{0x1a20, 0x1a54, incb_consonant},
+	{0x1a56, 0x1a56, incb_extend},
+	{0x1a58, 0x1a5e, incb_extend},
+	{0x1a60, 0x1a60, incb_linker},
+	{0x1a62, 0x1a62, incb_extend},
+	{0x1a65, 0x1a6c, incb_extend},
+	{0x1a73, 0x1a7c, incb_extend},
+	{0x1a7f, 0x1a7f, incb_extend},
+	{0x1ab0, 0x1add, incb_extend},
+	{0x1ae0, 0x1aeb, incb_extend},
+	{0x1b00, 0x1b03, incb_extend},
+	{0x1b0b, 0x1b0c, incb_consonant},
+	{0x1b13, 0x1b33, incb_consonant},
+	{0x1b34, 0x1b3d, incb_extend},
+	{0x1b42, 0x1b43, incb_extend},
+	{0x1b44, 0x1b44, incb_linker},
+	{0x1b45, 0x1b4c, incb_consonant},
+	{0x1b6b, 0x1b73, incb_extend},
+	{0x1b80, 0x1b81, incb_extend},
+	{0x1b83, 0x1ba0, incb_consonant},
+	{0x1ba2, 0x1ba5, incb_extend},
+	{0x1ba8, 0x1baa, incb_extend},
+	{0x1bab, 0x1bab, incb_linker},
+	{0x1bac, 0x1bad, incb_extend},
+	{0x1bae, 0x1baf, incb_consonant},
+	{0x1bbb, 0x1bbd, incb_consonant},
+	{0x1be6, 0x1be6, incb_extend},
+	{0x1be8, 0x1be9, incb_extend},
+	{0x1bed, 0x1bed, incb_extend},
+	{0x1bef, 0x1bf3, incb_extend},
+	{0x1c2c, 0x1c33, incb_extend},
+	{0x1c36, 0x1c37, incb_extend},
+	{0x1cd0, 0x1cd2, incb_extend},
+	{0x1cd4, 0x1ce0, incb_extend},
+	{0x1ce2, 0x1ce8, incb_extend},
+	{0x1ced, 0x1ced, incb_extend},
+	{0x1cf4, 0x1cf4, incb_extend},
+	{0x1cf8, 0x1cf9, incb_extend},
+	{0x1dc0, 0x1dff, incb_extend},
+	{0x200d, 0x200d, incb_extend},
+	{0x20d0, 0x20f0, incb_extend},
+	{0x2cef, 0x2cf1, incb_extend},
+	{0x2d7f, 0x2d7f, incb_extend},
+	{0x2de0, 0x2dff, incb_extend},
+	{0x302a, 0x302f, incb_extend},
+	{0x3099, 0x309a, incb_extend},
+	{0xa66f, 0xa672, incb_extend},
+	{0xa674, 0xa67d, incb_extend},
+	{0xa69e, 0xa69f, incb_extend},
+	{0xa6f0, 0xa6f1, incb_extend},
+	{0xa802, 0xa802, incb_extend},
+	{0xa806, 0xa806, incb_extend},
+	{0xa80b, 0xa80b, incb_extend},
+	{0xa825, 0xa826, incb_extend},
+	{0xa82c, 0xa82c, incb_extend},
+	{0xa8c4, 0xa8c5, incb_extend},
+	{0xa8e0, 0xa8f1, incb_extend},
+	{0xa8ff, 0xa8ff, incb_extend},
+	{0xa926, 0xa92d, incb_extend},
+	{0xa947, 0xa951, incb_extend},
+	{0xa953, 0xa953, incb_extend},
+	{0xa980, 0xa982, incb_extend},
+	{0xa989, 0xa98b, incb_consonant},
+	{0xa98f, 0xa9b2, incb_consonant},
+	{0xa9b3, 0xa9b3, incb_extend},
+	{0xa9b6, 0xa9b9, incb_extend},
+	{0xa9bc, 0xa9bd, incb_extend},
+	{0xa9c0, 0xa9c0, incb_linker},
+	{0xa9e0, 0xa9e4, incb_consonant},
+	{0xa9e5, 0xa9e5, incb_extend},
+	{0xa9e7, 0xa9ef, incb_consonant},
+	{0xa9fa, 0xa9fe, incb_consonant},
+	{0xaa29, 0xaa2e, incb_extend},
+	{0xaa31, 0xaa32, incb_extend},
+	{0xaa35, 0xaa36, incb_extend},
+	{0xaa43, 0xaa43, incb_extend},
+	{0xaa4c, 0xaa4c, incb_extend},
+	{0xaa60, 0xaa6f, incb_consonant},
+	{0xaa71, 0xaa73, incb_consonant},
+	{0xaa7a, 0xaa7a, incb_consonant},
+	{0xaa7c, 0xaa7c, incb_extend},
+	{0xaa7e, 0xaa7f, incb_consonant},
+	{0xaab0, 0xaab0, incb_extend},
+	{0xaab2, 0xaab4, incb_extend},
+	{0xaab7, 0xaab8, incb_extend},
+	{0xaabe, 0xaabf, incb_extend},
+	{0xaac1, 0xaac1, incb_extend},
+	{0xaae0, 0xaaea, incb_consonant},
+	{0xaaec, 0xaaed, incb_extend},
+	{0xaaf6, 0xaaf6, incb_linker},
+	{0xabc0, 0xabda, incb_consonant},
+	{0xabe5, 0xabe5, incb_extend},
+	{0xabe8, 0xabe8, incb_extend},
+	{0xabed, 0xabed, incb_extend},
+	{0xfb1e, 0xfb1e, incb_extend},
+	{0xfe00, 0xfe0f, incb_extend},
+	{0xfe20, 0xfe2f, incb_extend},
+	{0xff9e, 0xff9f, incb_extend},
+	{0x101fd, 0x101fd, incb_extend},
+	{0x102e0, 0x102e0, incb_extend},
+	{0x10376, 0x1037a, incb_extend},
+	{0x10a00, 0x10a00, incb_consonant},
+	{0x10a01, 0x10a03, incb_extend},
+	{0x10a05, 0x10a06, incb_extend},
+	{0x10a0c, 0x10a0f, incb_extend},
+	{0x10a10, 0x10a13, incb_consonant},
+	{0x10a15, 0x10a17, incb_consonant},
+	{0x10a19, 0x10a35, incb_consonant},
+	{0x10a38, 0x10a3a, incb_extend},
+	{0x10a3f, 0x10a3f, incb_linker},
+	{0x10ae5, 0x10ae6, incb_extend},
+	{0x10d24, 0x10d27, incb_extend},
+	{0x10d69, 0x10d6d, incb_extend},
+	{0x10eab, 0x10eac, incb_extend},
+	{0x10efa, 0x10eff, incb_extend},
+	{0x10f46, 0x10f50, incb_extend},
+	{0x10f82, 0x10f85, incb_extend},
+	{0x11001, 0x11001, incb_extend},
+	{0x11038, 0x11046, incb_extend},
+	{0x11070, 0x11070, incb_extend},
+	{0x11073, 0x11074, incb_extend},
+	{0x1107f, 0x11081, incb_extend},
+	{0x110b3, 0x110b6, incb_extend},
+	{0x110b9, 0x110ba, incb_extend},
+	{0x110c2, 0x110c2, incb_extend},
+	{0x11100, 0x11102, incb_extend},
+	{0x11103, 0x11126, incb_consonant},
+	{0x11127, 0x1112b, incb_extend},
+	{0x1112d, 0x11132, incb_extend},
+	{0x11133, 0x11133, incb_linker},
+	{0x11134, 0x11134, incb_extend},
+	{0x11144, 0x11144, incb_consonant},
+	{0x11147, 0x11147, incb_consonant},
+	{0x11173, 0x11173, incb_extend},
+	{0x11180, 0x11181, incb_extend},
+	{0x111b6, 0x111be, incb_extend},
+	{0x111c0, 0x111c0, incb_extend},
+	{0x111c9, 0x111cc, incb_extend},
+	{0x111cf, 0x111cf, incb_extend},
+	{0x1122f, 0x11231, incb_extend},
+	{0x11234, 0x11237, incb_extend},
+	{0x1123e, 0x1123e, incb_extend},
+	{0x11241, 0x11241, incb_extend},
+	{0x112df, 0x112df, incb_extend},
+	{0x112e3, 0x112ea, incb_extend},
+	{0x11300, 0x11301, incb_extend},
+	{0x1133b, 0x1133c, incb_extend},
+	{0x1133e, 0x1133e, incb_extend},
+	{0x11340, 0x11340, incb_extend},
+	{0x1134d, 0x1134d, incb_extend},
+	{0x11357, 0x11357, incb_extend},
+	{0x11366, 0x1136c, incb_extend},
+	{0x11370, 0x11374, incb_extend},
+	{0x11380, 0x11389, incb_consonant},
+	{0x1138b, 0x1138b, incb_consonant},
+	{0x1138e, 0x1138e, incb_consonant},
+	{0x11390, 0x113b5, incb_consonant},
+	{0x113b8, 0x113b8, incb_extend},
+	{0x113bb, 0x113c0, incb_extend},
+	{0x113c2, 0x113c2, incb_extend},
+	{0x113c5, 0x113c5, incb_extend},
+	{0x113c7, 0x113c9, incb_extend},
+	{0x113ce, 0x113cf, incb_extend},
+	{0x113d0, 0x113d0, incb_linker},
+	{0x113d2, 0x113d2, incb_extend},
+	{0x113e1, 0x113e2, incb_extend},
+	{0x11438, 0x1143f, incb_extend},
+	{0x11442, 0x11444, incb_extend},
+	{0x11446, 0x11446, incb_extend},
+	{0x1145e, 0x1145e, incb_extend},
+	{0x114b0, 0x114b0, incb_extend},
+	{0x114b3, 0x114b8, incb_extend},
+	{0x114ba, 0x114ba, incb_extend},
+	{0x114bd, 0x114bd, incb_extend},
+	{0x114bf, 0x114c0, incb_extend},
+	{0x114c2, 0x114c3, incb_extend},
+	{0x115af, 0x115af, incb_extend},
+	{0x115b2, 0x115b5, incb_extend},
+	{0x115bc, 0x115bd, incb_extend},
+	{0x115bf, 0x115c0, incb_extend},
+	{0x115dc, 0x115dd, incb_extend},
+	{0x11633, 0x1163a, incb_extend},
+	{0x1163d, 0x1163d, incb_extend},
+	{0x1163f, 0x11640, incb_extend},
+	{0x116ab, 0x116ab, incb_extend},
+	{0x116ad, 0x116ad, incb_extend},
+	{0x116b0, 0x116b7, incb_extend},
+	{0x1171d, 0x1171d, incb_extend},
+	{0x1171f, 0x1171f, incb_extend},
+	{0x11722, 0x11725, incb_extend},
+	{0x11727, 0x1172b, incb_extend},
+	{0x1182f, 0x11837, incb_extend},
+	{0x11839, 0x1183a, incb_extend},
+	{0x11900, 0x11906, incb_consonant},
+	{0x11909, 0x11909, incb_consonant},
+	{0x1190c, 0x11913, incb_consonant},
+	{0x11915, 0x11916, incb_consonant},
+	{0x11918, 0x1192f, incb_consonant},
+	{0x11930, 0x11930, incb_extend},
+	{0x1193b, 0x1193d, incb_extend},
+	{0x1193e, 0x1193e, incb_linker},
+	{0x11943, 0x11943, incb_extend},
+	{0x119d4, 0x119d7, incb_extend},
+	{0x119da, 0x119db, incb_extend},
+	{0x119e0, 0x119e0, incb_extend},
+	{0x11a00, 0x11a00, incb_consonant},
+	{0x11a01, 0x11a0a, incb_extend},
+	{0x11a0b, 0x11a32, incb_consonant},
+	{0x11a33, 0x11a38, incb_extend},
+	{0x11a3b, 0x11a3e, incb_extend},
+	{0x11a47, 0x11a47, incb_linker},
+	{0x11a50, 0x11a50, incb_consonant},
+	{0x11a51, 0x11a56, incb_extend},
+	{0x11a59, 0x11a5b, incb_extend},
+	{0x11a5c, 0x11a83, incb_consonant},
+	{0x11a8a, 0x11a96, incb_extend},
+	{0x11a98, 0x11a98, incb_extend},
+	{0x11a99, 0x11a99, incb_linker},
+	{0x11b60, 0x11b60, incb_extend},
+	{0x11b62, 0x11b64, incb_extend},
+	{0x11b66, 0x11b66, incb_extend},
+	{0x11c30, 0x11c36, incb_extend},
+	{0x11c38, 0x11c3d, incb_extend},
+	{0x11c3f, 0x11c3f, incb_extend},
+	{0x11c92, 0x11ca7, incb_extend},
+	{0x11caa, 0x11cb0, incb_extend},
+	{0x11cb2, 0x11cb3, incb_extend},
+	{0x11cb5, 0x11cb6, incb_extend},
+	{0x11d31, 0x11d36, incb_extend},
+	{0x11d3a, 0x11d3a, incb_extend},
+	{0x11d3c, 0x11d3d, incb_extend},
+	{0x11d3f, 0x11d45, incb_extend},
+	{0x11d47, 0x11d47, incb_extend},
+	{0x11d90, 0x11d91, incb_extend},
+	{0x11d95, 0x11d95, incb_extend},
+	{0x11d97, 0x11d97, incb_extend},
+	{0x11ef3, 0x11ef4, incb_extend},
+	{0x11f00, 0x11f01, incb_extend},
+	{0x11f04, 0x11f10, incb_consonant},
+	{0x11f12, 0x11f33, incb_consonant},
+	{0x11f36, 0x11f3a, incb_extend},
+	{0x11f40, 0x11f41, incb_extend},
+	{0x11f42, 0x11f42, incb_linker},
+	{0x11f5a, 0x11f5a, incb_extend},
+	{0x13440, 0x13440, incb_extend},
+	{0x13447, 0x13455, incb_extend},
+	{0x1611e, 0x16129, incb_extend},
+	{0x1612d, 0x1612f, incb_extend},
+	{0x16af0, 0x16af4, incb_extend},
+	{0x16b30, 0x16b36, incb_extend},
+	{0x16f4f, 0x16f4f, incb_extend},
+	{0x16f8f, 0x16f92, incb_extend},
+	{0x16fe4, 0x16fe4, incb_extend},
+	{0x16ff0, 0x16ff1, incb_extend},
+	{0x1bc9d, 0x1bc9e, incb_extend},
+	{0x1cf00, 0x1cf2d, incb_extend},
+	{0x1cf30, 0x1cf46, incb_extend},
+	{0x1d165, 0x1d169, incb_extend},
+	{0x1d16d, 0x1d172, incb_extend},
+	{0x1d17b, 0x1d182, incb_extend},
+	{0x1d185, 0x1d18b, incb_extend},
+	{0x1d1aa, 0x1d1ad, incb_extend},
+	{0x1d242, 0x1d244, incb_extend},
+	{0x1da00, 0x1da36, incb_extend},
+	{0x1da3b, 0x1da6c, incb_extend},
+	{0x1da75, 0x1da75, incb_extend},
+	{0x1da84, 0x1da84, incb_extend},
+	{0x1da9b, 0x1da9f, incb_extend},
+	{0x1daa1, 0x1daaf, incb_extend},
+	{0x1e000, 0x1e006, incb_extend},
+	{0x1e008, 0x1e018, incb_extend},
+	{0x1e01b, 0x1e021, incb_extend},
+	{0x1e023, 0x1e024, incb_extend},
+	{0x1e026, 0x1e02a, incb_extend},
+	{0x1e08f, 0x1e08f, incb_extend},
+	{0x1e130, 0x1e136, incb_extend},
+	{0x1e2ae, 0x1e2ae, incb_extend},
+	{0x1e2ec, 0x1e2ef, incb_extend},
+	{0x1e4ec, 0x1e4ef, incb_extend},
+	{0x1e5ee, 0x1e5ef, incb_extend},
+	{0x1e6e3, 0x1e6e3, incb_extend},
+	{0x1e6e6, 0x1e6e6, incb_extend},
+	{0x1e6ee, 0x1e6ef, incb_extend},
+	{0x1e6f5, 0x1e6f5, incb_extend},
+	{0x1e8d0, 0x1e8d6, incb_extend},
+	{0x1e944, 0x1e94a, incb_extend},
+	{0x1f3fb, 0x1f3ff, incb_extend},
+	{0xe0020, 0xe007f, incb_extend},
+	{0xe0100, 0xe01ef, incb_extend},
+}
@@ -0,0 +1,59 @@
+package uhd
+
+import (
+	"bufio"
+	"os"
+	"slices"
+	"strconv"
+	"strings"
+	"testing"
+)
+
+func TestGraphemeSize_conformance(t *testing.T) {
+	// every test of UAX #29 of the Unicode version the tables are generated from
+	fp, err := os.Open("testdata/ucd/auxiliary/GraphemeBreakTest.txt")
+	if err != nil {
+		t.Fatal("open", "err", err)
+	}
+	defer fp.Close()
+	scanner := bufio.NewScanner(fp)
+	for scanner.Scan() {
+		line, _, _ := strings.Cut(scanner.Text(), "#")
+		if strings.TrimSpace(line) == "" {
+			continue
+		}
+		input := []byte{}
+		expected := []int{}
+		size := 0
+		for _, field := range strings.Fields(line) {
+			switch field {
+			case "÷":
+				if size != 0 {
+					expected = append(expected, size)
+				}
+				size = 0
+			case "×":
+			default:
+				r, err := strconv.ParseUint(field, 16, 32)
+				if err != nil {
+					t.Fatal("code point", "err", err)
+				}
+				n := len(input)
+				input = append(input, string(rune(r))...)
+				size += len(input) - n
+			}
+		}
+		got := []int{}
+		for p := input; len(p) != 0; {
+			n, _ := grapheme_size(p, true)
+			got = append(got, n)
+			p = p[n:]
+		}
+		if !slices.Equal(got, expected) {
+			t.Errorf("unexpected output of %s:\ngot:  %v\nwant: %v", strings.TrimSpace(line), got, expected)
+		}
+	}
+	if err := scanner.Err(); err != nil {
+		t.Fatal("scan", "err", err)
+	}
+}
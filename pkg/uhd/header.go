@@ -0,0 +1,161 @@
+package uhd
+
+import (
+	"cmp"
+	"fmt"
+	"io"
+	"log/slog"
+	"slices"
+	"strings"
+)
+
+type Header struct {
+	output io.Writer
+	cur    uint64
+	width  int
+	lower  bool
+	// format is the radix of the address: "hex", "dec", "oct" or "none"
+	format string
+	digits int
+	base   uint64
+}
+
+// address_formats lists the radixes of an address column.
+var address_formats = []string{"hex", "dec", "oct", "none"}
+
+// format_address returns addr in format, zero-padded to digits.
+func format_address(addr uint64, format string, digits int, lower bool) string {
+	switch format {
+	case "dec":
+		return fmt.Sprintf("%0*d", digits, addr)
+	case "oct":
+		return fmt.Sprintf("%0*o", digits, addr)
+	case "none":
+		return ""
+	}
+	if lower {
+		return fmt.Sprintf("%0*x", digits, addr)
+	}
+	return fmt.Sprintf("%0*X", digits, addr)
+}
+
+// address_digits returns the digits of the addresses up to last in format,
+// at least 8.
+func address_digits(format string, last uint64) int {
+	if format == "none" {
+		return 0
+	}
+	return max(8, len(format_address(last, format, 0, false)))
+}
+
+func (h *Header) Write(p []byte) (n int, err error) {
+	for i := h.cur; i < h.cur+uint64(len(p)); i++ {
+		if i%uint64(h.width) == 0 {
+			fmt.Fprintln(h.output, format_address(h.base+i, h.format, h.digits, h.lower))
+		}
+	}
+	h.cur += uint64(len(p))
+	return len(p), nil
+}
+
+// SetOffset starts the address at offset. The first row is labelled with the
+// aligned row address.
+func (h *Header) SetOffset(offset uint64) {
+	h.cur = offset
+	if rowstart := offset - offset%uint64(h.width); rowstart != offset {
+		fmt.Fprintln(h.output, format_address(h.base+rowstart, h.format, h.digits, h.lower))
+	}
+}
+
+func (h *Header) Close() (err error) {
+	if closer, ok := h.output.(io.Closer); ok {
+		if err := closer.Close(); err != nil {
+			slog.Error("close writer", "err", err)
+			return err
+		}
+	}
+	return nil
+}
+
+func NewHeader(output io.Writer, width int) *Header {
+	return &Header{
+		output: output,
+		cur:    0,
+		width:  width,
+		lower:  false,
+		format: "hex",
+		digits: 8,
+	}
+}
+
+func NewHeaderLower(output io.Writer, width int) *Header {
+	return &Header{
+		output: output,
+		cur:    0,
+		width:  width,
+		lower:  true,
+		format: "hex",
+		digits: 8,
+	}
+}
+
+// fit_address sets the digits and the width of the header columns for the
+// addresses up to base+limit.
+func (l *Layout) fit_address() {
+	last := l.base + max(l.limit, 1) - 1
+	for idx, col := range l.columns {
+		if col.name == "header" {
+			l.columns[idx].digits = address_digits(cmp.Or(col.address, "hex"), last)
+			l.columns[idx].width = column_width(l.columns[idx], l.width, l.sep)
+		}
+	}
+}
+
+// SetAddressFormat sets the radix of the header columns that do not have one:
+// "hex", "dec", "oct" or "none".
+func (l *Layout) SetAddressFormat(format string) error {
+	format = strings.ToLower(format)
+	if !slices.Contains(address_formats, format) {
+		return fmt.Errorf("invalid address format: %q", format)
+	}
+	for idx, col := range l.columns {
+		if col.name == "header" && col.address == "" {
+			l.columns[idx].address = format
+		}
+	}
+	l.fit_address()
+	return nil
+}
+
+// SetBaseAddress adds base to the addresses shown, such as the load address
+// of a firmware image.
+func (l *Layout) SetBaseAddress(base uint64) {
+	l.base = base
+	l.fit_address()
+}
+
+// SetAddressLimit widens the header columns for an input of size bytes, so
+// that the addresses of a large file line up.
+func (l *Layout) SetAddressLimit(size uint64) {
+	l.limit = size
+	l.fit_address()
+}
+
+// AddOtherRadix adds a header column after each one with the address in the
+// other radix: decimal for hex, and hex for decimal and octal.
+func (l *Layout) AddOtherRadix() {
+	columns := make([]column, 0, 2*len(l.columns))
+	for _, col := range l.columns {
+		columns = append(columns, col)
+		if col.name != "header" || col.address == "none" {
+			continue
+		}
+		other := column{name: "header", lower: col.lower, address: "hex"}
+		if cmp.Or(col.address, "hex") == "hex" {
+			other.address = "dec"
+		}
+		columns = append(columns, other)
+	}
+	l.columns = columns
+	l.fit_address()
+}
@@ -0,0 +1,99 @@
+package uhd
+
+import (
+	"bufio"
+	"bytes"
+	"fmt"
+	"io"
+	"testing"
+)
+
+func TestHeader(t *testing.T) {
+	buf := &bytes.Buffer{}
+	hdr := NewHeader(buf, 16)
+	n, err := hdr.Write(make([]byte, 128))
+	if err != nil {
+		t.Error("write", "err", err)
+	}
+	if err = hdr.Close(); err != nil {
+		t.Error("close", "err", err)
+	}
+	if n != 128 {
+		t.Error("short write", "n", n, "expected", 128)
+	}
+	rd := bufio.NewReader(buf)
+	for i := range 128 / 16 {
+		line, _, err := rd.ReadLine()
+		if err != nil {
+			t.Error("readline", "err", err)
+		}
+		if string(line) != fmt.Sprintf("%08X", i*16) {
+			t.Error("invalid line", "line", string(line), "i", i)
+		}
+	}
+	if _, _, err = rd.ReadLine(); err != io.EOF {
+		t.Error("no eof")
+	}
+}
+
+func TestHeader_SetOffset(t *testing.T) {
+	buf := &bytes.Buffer{}
+	hdr := NewHeader(buf, 16)
+	hdr.SetOffset(0x1f005)
+	if _, err := hdr.Write(make([]byte, 32)); err != nil {
+		t.Error("write", "err", err)
+	}
+	if err := hdr.Close(); err != nil {
+		t.Error("close", "err", err)
+	}
+	expected := "0001F000\n0001F010\n0001F020\n"
+	if buf.String() != expected {
+		t.Errorf("unexpected output:\ngot:  %q\nwant: %q", buf.String(), expected)
+	}
+}
+
+func TestHeader_Format(t *testing.T) {
+	tests := []struct {
+		format   string
+		digits   int
+		base     uint64
+		expected string
+	}{
+		{"hex", 8, 0x08000000, "08000000\n08000010\n"},
+		{"dec", 8, 0, "00000000\n00000016\n"},
+		{"oct", 8, 0, "00000000\n00000020\n"},
+		{"none", 0, 0, "\n\n"},
+		{"hex", 10, 0xffffffff0, "0FFFFFFFF0\n1000000000\n"},
+	}
+	for _, tt := range tests {
+		buf := &bytes.Buffer{}
+		hdr := NewHeader(buf, 16)
+		hdr.format, hdr.digits, hdr.base = tt.format, tt.digits, tt.base
+		if _, err := hdr.Write(make([]byte, 32)); err != nil {
+			t.Error("write", "err", err)
+		}
+		if buf.String() != tt.expected {
+			t.Errorf("unexpected output:\ngot:  %q\nwant: %q", buf.String(), tt.expected)
+		}
+	}
+}
+
+func TestAddressDigits(t *testing.T) {
+	tests := []struct {
+		format   string
+		last     uint64
+		expected int
+	}{
+		{"hex", 0xff, 8},
+		{"hex", 0x1_0000_0000, 9},
+		{"dec", 99_999_999, 8},
+		{"dec", 100_000_000, 9},
+		{"oct", 1 << 24, 9},
+		{"none", 1 << 40, 0},
+	}
+	for _, tt := range tests {
+		if got := address_digits(tt.format, tt.last); got != tt.expected {
+			t.Errorf("address_digits(%q, %d) = %d, want %d", tt.format, tt.last, got, tt.expected)
+		}
+	}
+}
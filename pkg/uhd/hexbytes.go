@@ -1,19 +1,20 @@
-package main
+package uhd
 
 import (
 	"fmt"
 	"io"
 	"log/slog"
+	"strings"
 )
 
-type hexbytes struct {
+type Hexbytes struct {
 	output io.Writer
 	cur    uint64
 	width  int
 	lower  bool
 }
 
-func (h *hexbytes) Write(p []byte) (n int, err error) {
+func (h *Hexbytes) Write(p []byte) (n int, err error) {
 	for i, ch := range p {
 		if h.lower {
 			fmt.Fprintf(h.output, "0x%02x,", uint8(ch))
@@ -30,7 +31,14 @@ func (h *hexbytes) Write(p []byte) (n int, err error) {
 	return len(p), nil
 }
 
-func (h *hexbytes) Close() (err error) {
+// SetOffset starts the dump at offset, leaving blanks for the bytes before it
+// in the first row.
+func (h *Hexbytes) SetOffset(offset uint64) {
+	h.cur = offset
+	fmt.Fprint(h.output, strings.Repeat("      ", int(offset%uint64(h.width))))
+}
+
+func (h *Hexbytes) Close() (err error) {
 	if h.cur%uint64(h.width) != 0 {
 		fmt.Fprint(h.output, "\n")
 	}
@@ -43,8 +51,8 @@ func (h *hexbytes) Close() (err error) {
 	return nil
 }
 
-func NewHexbytes(output io.Writer, width int) *hexbytes {
-	return &hexbytes{
+func NewHexbytes(output io.Writer, width int) *Hexbytes {
+	return &Hexbytes{
 		output: output,
 		cur:    0,
 		width:  width,
@@ -52,8 +60,8 @@ func NewHexbytes(output io.Writer, width int) *hexbytes {
 	}
 }
 
-func NewHexbytesLower(output io.Writer, width int) *hexbytes {
-	return &hexbytes{
+func NewHexbytesLower(output io.Writer, width int) *Hexbytes {
+	return &Hexbytes{
 		output: output,
 		cur:    0,
 		width:  width,
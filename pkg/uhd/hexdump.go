@@ -1,4 +1,4 @@
-package main
+package uhd
 
 import (
 	"fmt"
@@ -6,7 +6,7 @@ import (
 	"log/slog"
 )
 
-type hexdump struct {
+type Hexdump struct {
 	output io.Writer
 	cur    uint64
 	width  int
@@ -14,7 +14,7 @@ type hexdump struct {
 	lower  bool
 }
 
-func (h *hexdump) Write(p []byte) (n int, err error) {
+func (h *Hexdump) Write(p []byte) (n int, err error) {
 	for i, ch := range p {
 		if h.lower {
 			fmt.Fprintf(h.output, " %02x", uint8(ch))
@@ -33,7 +33,19 @@ func (h *hexdump) Write(p []byte) (n int, err error) {
 	return len(p), nil
 }
 
-func (h *hexdump) Close() (err error) {
+// SetOffset starts the dump at offset, leaving blanks for the bytes before it
+// in the first row.
+func (h *Hexdump) SetOffset(offset uint64) {
+	h.cur = offset
+	for cw := 0; cw < int(offset%uint64(h.width)); cw++ {
+		fmt.Fprint(h.output, "   ")
+		if cw%h.sep == h.sep-1 {
+			fmt.Fprint(h.output, " ")
+		}
+	}
+}
+
+func (h *Hexdump) Close() (err error) {
 	if h.cur%uint64(h.width) != 0 {
 		fmt.Fprint(h.output, "\n")
 	}
@@ -46,8 +58,8 @@ func (h *hexdump) Close() (err error) {
 	return nil
 }
 
-func NewHexdump(output io.Writer, width int, sep int) *hexdump {
-	return &hexdump{
+func NewHexdump(output io.Writer, width int, sep int) *Hexdump {
+	return &Hexdump{
 		output: output,
 		cur:    0,
 		width:  width,
@@ -56,8 +68,8 @@ func NewHexdump(output io.Writer, width int, sep int) *hexdump {
 	}
 }
 
-func NewHexdumpLower(output io.Writer, width int, sep int) *hexdump {
-	return &hexdump{
+func NewHexdumpLower(output io.Writer, width int, sep int) *Hexdump {
+	return &Hexdump{
 		output: output,
 		cur:    0,
 		width:  width,
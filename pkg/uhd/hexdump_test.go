@@ -1,4 +1,4 @@
-package main
+package uhd
 
 import (
 	"bytes"
@@ -47,3 +47,20 @@ func TestHexdumpLower(t *testing.T) {
 		t.Error("mismatch", "output", output, "expected", expected)
 	}
 }
+
+func TestHexdump_SetOffset(t *testing.T) {
+	buf := &bytes.Buffer{}
+	hex := NewHexdump(buf, 16, 8)
+	hex.SetOffset(0x1f00a)
+	input := "hello world"
+	expected := "                                68 65 6C 6C 6F 20\n 77 6F 72 6C 64\n"
+	if _, err := fmt.Fprint(hex, input); err != nil {
+		t.Error("write", "err", err)
+	}
+	if err := hex.Close(); err != nil {
+		t.Error("close", "err", err)
+	}
+	if buf.String() != expected {
+		t.Errorf("unexpected output:\ngot:  %q\nwant: %q", buf.String(), expected)
+	}
+}
@@ -0,0 +1,293 @@
+package uhd
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"io"
+	"log/slog"
+	"regexp"
+	"slices"
+	"strconv"
+	"strings"
+)
+
+var ansiEscape = regexp.MustCompile("\x1b\\[[0-9;]*[A-Za-z]")
+
+// errBareSqueeze is returned for a "*" line that no offset follows, as the
+// number of rows it stands for is unknown.
+var errBareSqueeze = errors.New(`"*" without offsets: the squeezed rows cannot be restored, dump with --squeeze-count or --no-squeeze`)
+
+type Hexrev struct {
+	output  io.Writer
+	cur     uint64
+	line    []byte
+	prev    []byte
+	squeeze bool
+	// sep is the number of bytes between the extra spaces of a hexdump row
+	sep int
+	// format is the radix of the offset column and base the address of the
+	// first byte, as given to the dump. other tells the dump has a second
+	// offset column in the other radix.
+	format string
+	base   uint64
+	other  bool
+}
+
+func isHex(s string) bool {
+	if s == "" {
+		return false
+	}
+	for _, c := range s {
+		if !strings.ContainsRune("0123456789abcdefABCDEF", c) {
+			return false
+		}
+	}
+	return true
+}
+
+// address_radix returns the radix of an address format, or 0 for "none".
+func address_radix(format string) int {
+	switch format {
+	case "dec":
+		return 10
+	case "oct":
+		return 8
+	case "none":
+		return 0
+	}
+	return 16
+}
+
+// parseLine reads one line of uhd output (jhd, hexdump or bytes layout) or
+// bare space-separated hex. It returns the offset column (if any) and the
+// bytes of the hex column. The printable column is ignored.
+func (h *Hexrev) parseLine(line string) (offset uint64, has_offset bool, data []byte) {
+	line = ansiEscape.ReplaceAllString(line, "")
+	line = strings.TrimRight(line, "\r\n")
+	pos := 0
+	skipSpaces := func() int {
+		start := pos
+		for pos < len(line) && (line[pos] == ' ' || line[pos] == '\t') {
+			pos++
+		}
+		return pos - start
+	}
+	nextToken := func() string {
+		start := pos
+		for pos < len(line) && line[pos] != ' ' && line[pos] != '\t' {
+			pos++
+		}
+		return line[start:pos]
+	}
+	skipSpaces()
+	save := pos
+	first := nextToken()
+	found := false
+	if radix := address_radix(h.format); radix != 0 && len(first) > 2 {
+		if val, err := strconv.ParseUint(first, radix, 64); err == nil {
+			found = true
+			if val < h.base {
+				slog.Warn("offset below the base address", "offset", val, "base", h.base)
+			} else {
+				offset = val - h.base
+				has_offset = true
+			}
+		}
+	}
+	if !found {
+		pos = save
+	} else if h.other {
+		skipSpaces()
+		nextToken()
+	}
+	prefixed := false
+	for pos < len(line) {
+		spaces := skipSpaces()
+		if len(data) != 0 && spaces >= 3 {
+			break
+		}
+		token := nextToken()
+		token = strings.TrimSuffix(token, ",")
+		if strings.HasPrefix(token, "0x") || strings.HasPrefix(token, "0X") {
+			if len(data) == 0 {
+				prefixed = true
+			} else if !prefixed {
+				break
+			}
+			token = token[2:]
+		} else if prefixed {
+			break
+		}
+		if len(token) != 2 || !isHex(token) {
+			break
+		}
+		if len(data) == 0 && has_offset {
+			// the first row of a dump started with --skip has blank cells
+			if prefixed {
+				offset += uint64((spaces - 1) / 6)
+			} else {
+				offset += uint64(h.blank_cells(spaces))
+			}
+		}
+		val, _ := strconv.ParseUint(token, 16, 8)
+		data = append(data, byte(val))
+	}
+	return
+}
+
+// blank_cells returns the number of byte cells that the spaces before the
+// first byte of a hexdump row stand for, with the extra space every sep bytes.
+func (h *Hexrev) blank_cells(spaces int) int {
+	cell := func(idx int) int {
+		if h.sep <= 0 {
+			return 3*idx + 2
+		}
+		return 3*idx + idx/h.sep + 2
+	}
+	idx := 0
+	for cell(idx+1) <= spaces {
+		idx++
+	}
+	return idx
+}
+
+func (h *Hexrev) write(p []byte) error {
+	written, err := h.output.Write(p)
+	if err != nil {
+		slog.Error("write", "err", err, "written", written)
+		return err
+	}
+	if written != len(p) {
+		slog.Warn("short write", "written", written, "expected", len(p))
+	}
+	h.cur += uint64(written)
+	return nil
+}
+
+// seek fills the gap up to offset, repeating the previous row if the gap was
+// squeezed with "*" and padding the rest with zero.
+func (h *Hexrev) seek(offset uint64) error {
+	if offset < h.cur {
+		slog.Warn("offset goes backward", "offset", offset, "current", h.cur)
+		return nil
+	}
+	if h.squeeze && len(h.prev) != 0 {
+		for h.cur+uint64(len(h.prev)) <= offset {
+			if err := h.write(h.prev); err != nil {
+				return err
+			}
+		}
+	}
+	if offset > h.cur {
+		slog.Debug("zero fill", "from", h.cur, "to", offset)
+		if err := h.write(make([]byte, offset-h.cur)); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// squeezed reads a "*" line. The rows of "* (N rows, ...)" are the previous
+// row N more times, and the ones of a bare "*" are left to the offset of the
+// next line.
+func (h *Hexrev) squeezed(line string) error {
+	var rows int
+	if _, err := fmt.Sscanf(line, "* (%d ", &rows); err == nil {
+		if len(h.prev) == 0 {
+			return fmt.Errorf("no row to repeat: %q", line)
+		}
+		for range rows {
+			if err := h.write(h.prev); err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+	if address_radix(h.format) == 0 {
+		return errBareSqueeze
+	}
+	h.squeeze = true
+	return nil
+}
+
+func (h *Hexrev) processLine(line string) error {
+	if trimmed := strings.TrimSpace(ansiEscape.ReplaceAllString(line, "")); trimmed == "*" || strings.HasPrefix(trimmed, "* (") {
+		return h.squeezed(trimmed)
+	}
+	offset, has_offset, data := h.parseLine(line)
+	if has_offset {
+		if err := h.seek(offset); err != nil {
+			return err
+		}
+	} else if h.squeeze && len(data) != 0 {
+		return errBareSqueeze
+	}
+	h.squeeze = false
+	if len(data) == 0 {
+		return nil
+	}
+	h.prev = data
+	return h.write(data)
+}
+
+func (h *Hexrev) Write(p []byte) (n int, err error) {
+	h.line = append(h.line, p...)
+	for {
+		idx := bytes.IndexByte(h.line, '\n')
+		if idx == -1 {
+			break
+		}
+		line := string(h.line[:idx])
+		h.line = h.line[idx+1:]
+		if err := h.processLine(line); err != nil {
+			return len(p), err
+		}
+	}
+	return len(p), nil
+}
+
+func (h *Hexrev) Close() error {
+	if len(h.line) != 0 {
+		line := string(h.line)
+		h.line = nil
+		return h.processLine(line)
+	}
+	return nil
+}
+
+// SetSep sets the number of bytes between the extra spaces of the dump, to
+// place the first byte of a row started with --skip.
+func (h *Hexrev) SetSep(sep int) {
+	h.sep = sep
+}
+
+// SetAddressFormat sets the radix of the offset column: "hex", "dec", "oct",
+// or "none" for a dump without offsets.
+func (h *Hexrev) SetAddressFormat(format string) error {
+	format = strings.ToLower(format)
+	if !slices.Contains(address_formats, format) {
+		return fmt.Errorf("invalid address format: %q", format)
+	}
+	h.format = format
+	return nil
+}
+
+// SetBaseAddress sets the address shown for the first byte, which is
+// subtracted from the offsets.
+func (h *Hexrev) SetBaseAddress(base uint64) {
+	h.base = base
+}
+
+// SkipOtherRadix reads dumps with a second offset column in the other radix.
+func (h *Hexrev) SkipOtherRadix() {
+	h.other = true
+}
+
+func NewHexrev(output io.Writer) *Hexrev {
+	return &Hexrev{
+		output: output,
+		sep:    8,
+		format: "hex",
+	}
+}
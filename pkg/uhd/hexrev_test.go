@@ -0,0 +1,223 @@
+package uhd
+
+import (
+	"bytes"
+	"cmp"
+	"errors"
+	"fmt"
+	"io"
+	"testing"
+)
+
+func TestHexrev(t *testing.T) {
+	textdata := "01 12 34 56 78 9a bc de f0\n"
+	buf := &bytes.Buffer{}
+	hr := NewHexrev(buf)
+	n, err := fmt.Fprint(hr, textdata)
+	if err != nil {
+		t.Error("fprint", "err", err)
+	}
+	if err = hr.Close(); err != nil {
+		t.Error("close", "err", err)
+	}
+	if n != len(textdata) {
+		t.Error("short write", "n", n, "len(textdata)", len(textdata))
+	}
+	if !bytes.Equal(buf.Bytes(), []byte{0x01, 0x12, 0x34, 0x56, 0x78, 0x9a, 0xbc, 0xde, 0xf0}) {
+		t.Error("mismatch", "buf.Bytes()", buf.Bytes(), "expected", textdata)
+	}
+}
+
+func TestHexrev_Layouts(t *testing.T) {
+	expected := []byte("hello world 1234567890\n")
+	inputs := map[string]string{
+		"jhd": "00000000  68 65 6C 6C 6F 20 77 6F  72 6C 64 20 31 32 33 34    hello world 1234\n" +
+			"00000010  35 36 37 38 39 30 0A                                567890.\n",
+		"hexdump": "00000000  68 65 6c 6c 6f 20 77 6f  72 6c 64 20 31 32 33 34    |hello world 1234|\n" +
+			"00000010  35 36 37 38 39 30 0a                                |567890.\n",
+		"bytes": "00000000 0x68, 0x65, 0x6c, 0x6c, 0x6f, 0x20, 0x77, 0x6f, 0x72, 0x6c, 0x64, 0x20, 0x31, 0x32, 0x33, 0x34,  hello world 1234\n" +
+			"00000010 0x35, 0x36, 0x37, 0x38, 0x39, 0x30, 0x0a,                                                       567890.\n",
+	}
+	for name, textdata := range inputs {
+		buf := &bytes.Buffer{}
+		hr := NewHexrev(buf)
+		if _, err := fmt.Fprint(hr, textdata); err != nil {
+			t.Error(name, "fprint", "err", err)
+		}
+		if err := hr.Close(); err != nil {
+			t.Error(name, "close", "err", err)
+		}
+		if !bytes.Equal(buf.Bytes(), expected) {
+			t.Error(name, "mismatch", "buf.Bytes()", buf.Bytes(), "expected", expected)
+		}
+	}
+}
+
+func TestHexrev_Squeeze(t *testing.T) {
+	for _, squeezed := range []string{"*", "* (2 rows, 0x20 bytes of 41)"} {
+		testHexrevSqueeze(t, squeezed)
+	}
+}
+
+func testHexrevSqueeze(t *testing.T, squeezed string) {
+	textdata := "00000000  41 41 41 41 41 41 41 41  41 41 41 41 41 41 41 41    AAAAAAAAAAAAAAAA\n" +
+		squeezed + "\n" +
+		"00000030  41 41 41 41 42                                      AAAAB\n" +
+		"00000040  43"
+	buf := &bytes.Buffer{}
+	hr := NewHexrev(buf)
+	if _, err := fmt.Fprint(hr, textdata); err != nil {
+		t.Error("fprint", "err", err)
+	}
+	if err := hr.Close(); err != nil {
+		t.Error("close", "err", err)
+	}
+	expected := append(bytes.Repeat([]byte{0x41}, 0x34), 0x42)
+	expected = append(expected, make([]byte, 0x40-len(expected))...)
+	expected = append(expected, 0x43)
+	if !bytes.Equal(buf.Bytes(), expected) {
+		t.Error("mismatch", "buf.Bytes()", buf.Bytes(), "expected", expected)
+	}
+}
+
+func TestHexrev_Width(t *testing.T) {
+	// rows wider than 16 bytes are read up to the printable column
+	data := make([]byte, 80)
+	for idx := range data {
+		data[idx] = byte(idx)
+	}
+	for _, opts := range []Options{{Width: 32}, {Width: 32, Layout: "bytes"}, {Width: 24, Sep: 4, Layout: "hexdump"}} {
+		dump := &bytes.Buffer{}
+		d := NewDumper(dump, opts)
+		if _, err := d.Write(data); err != nil {
+			t.Fatal("write", "err", err)
+		}
+		if err := d.Close(); err != nil {
+			t.Fatal("close", "err", err)
+		}
+		buf := &bytes.Buffer{}
+		hr := NewHexrev(buf)
+		if _, err := hr.Write(dump.Bytes()); err != nil {
+			t.Error("write", "err", err)
+		}
+		if err := hr.Close(); err != nil {
+			t.Error("close", "err", err)
+		}
+		if !bytes.Equal(buf.Bytes(), data) {
+			t.Error("mismatch", "opts", opts, "buf.Bytes()", buf.Bytes())
+		}
+	}
+}
+
+func TestHexrev_Address(t *testing.T) {
+	// 300 bytes with a zero-filled run that is squeezed
+	data := make([]byte, 300)
+	for idx := range data[:100] {
+		data[idx] = byte(idx)
+	}
+	data[299] = 0xff
+	for _, opts := range []Options{
+		{AddressFormat: "dec"},
+		{AddressFormat: "oct"},
+		{AddressFormat: "none", NoSqueeze: true},
+		{OtherRadix: true},
+		{AddressFormat: "dec", OtherRadix: true, Layout: "bytes"},
+		{BaseAddress: 0x1000},
+		{BaseAddress: 0x08000000, OtherRadix: true, Layout: "hexdump"},
+		{AddressFormat: "oct", BaseAddress: 0o777, SqueezeCount: true},
+		{AddressFormat: "none", SqueezeCount: true},
+		{AddressFormat: "none", SqueezeCount: true, Layout: "bytes"},
+	} {
+		dump := &bytes.Buffer{}
+		d := NewDumper(dump, opts)
+		if _, err := d.Write(data); err != nil {
+			t.Fatal("write", "err", err)
+		}
+		if err := d.Close(); err != nil {
+			t.Fatal("close", "err", err)
+		}
+		buf := &bytes.Buffer{}
+		hr := NewHexrev(buf)
+		if err := hr.SetAddressFormat(cmp.Or(opts.AddressFormat, "hex")); err != nil {
+			t.Fatal("address format", "err", err)
+		}
+		hr.SetBaseAddress(opts.BaseAddress)
+		if opts.OtherRadix {
+			hr.SkipOtherRadix()
+		}
+		if _, err := hr.Write(dump.Bytes()); err != nil {
+			t.Error("write", "err", err)
+		}
+		if err := hr.Close(); err != nil {
+			t.Error("close", "err", err)
+		}
+		if !bytes.Equal(buf.Bytes(), data) {
+			t.Errorf("mismatch of %+v: %d bytes\n%s", opts, buf.Len(), dump.String())
+		}
+	}
+}
+
+func TestHexrev_BareSqueeze(t *testing.T) {
+	// a "*" without offsets fails instead of dropping the squeezed rows
+	dump := &bytes.Buffer{}
+	d := NewDumper(dump, Options{AddressFormat: "none"})
+	if _, err := d.Write(make([]byte, 64)); err != nil {
+		t.Fatal("write", "err", err)
+	}
+	if err := d.Close(); err != nil {
+		t.Fatal("close", "err", err)
+	}
+	for format, textdata := range map[string]string{
+		"none": dump.String(),
+		"hex":  "41 41 41 41\n*\n41 42\n",
+	} {
+		hr := NewHexrev(io.Discard)
+		if err := hr.SetAddressFormat(format); err != nil {
+			t.Fatal("address format", "err", err)
+		}
+		_, err := fmt.Fprint(hr, textdata)
+		if err == nil {
+			err = hr.Close()
+		}
+		if !errors.Is(err, errBareSqueeze) {
+			t.Errorf("unexpected error of %s: %v\n%s", format, err, textdata)
+		}
+	}
+}
+
+func TestHexrev_SkipSep(t *testing.T) {
+	// the blank cells of the first row of a --skip dump include the extra
+	// space every Sep bytes
+	data := make([]byte, 64)
+	for idx := range data {
+		data[idx] = byte(0x80 + idx)
+	}
+	for _, opts := range []Options{
+		{Sep: 4, Offset: 13},
+		{Sep: 2, Offset: 9, Layout: "hexdump"},
+		{Sep: 2, Offset: 15},
+		{Sep: 3, Offset: 7, Width: 12},
+	} {
+		dump := &bytes.Buffer{}
+		d := NewDumper(dump, opts)
+		if _, err := d.Write(data[opts.Offset:]); err != nil {
+			t.Fatal("write", "err", err)
+		}
+		if err := d.Close(); err != nil {
+			t.Fatal("close", "err", err)
+		}
+		buf := &bytes.Buffer{}
+		hr := NewHexrev(buf)
+		hr.SetSep(opts.Sep)
+		if _, err := hr.Write(dump.Bytes()); err != nil {
+			t.Error("write", "err", err)
+		}
+		if err := hr.Close(); err != nil {
+			t.Error("close", "err", err)
+		}
+		expected := append(make([]byte, opts.Offset), data[opts.Offset:]...)
+		if !bytes.Equal(buf.Bytes(), expected) {
+			t.Errorf("mismatch of %+v:\n%s\ngot: % x", opts, dump.String(), buf.Bytes())
+		}
+	}
+}
@@ -0,0 +1,224 @@
+package uhd
+
+import (
+	"fmt"
+	"html"
+	"io"
+	"strings"
+	"unicode/utf8"
+)
+
+const html_head = `<!DOCTYPE html>
+<html>
+<head>
+<meta charset="utf-8">
+<title>%s</title>
+<style>
+body { background: #fff; color: #000; }
+pre.uhd { font-family: monospace; line-height: 1.3; }
+.addr { color: #666; }
+.pad { color: #08a; }
+.invalid { color: #00c; }
+.bom { color: #080; }
+.esc { color: #a0a; }
+.variant { color: #a60; }
+.ctrl { color: #00c; }
+.hl { background: #fd0; }
+</style>
+</head>
+<body>
+<pre class="uhd">
+`
+
+// html_tail highlights the bytes of the character or byte under the mouse.
+const html_tail = `</pre>
+<script>
+const uhd = document.querySelector("pre.uhd");
+let marked = [];
+uhd.addEventListener("mouseover", (ev) => {
+  marked.forEach((el) => el.classList.remove("hl"));
+  marked = [];
+  const target = ev.target.closest("[data-o]");
+  if (!target) return;
+  const start = Number(target.dataset.o);
+  const end = start + Number(target.dataset.n || 1);
+  uhd.querySelectorAll("[data-o]").forEach((el) => {
+    const o = Number(el.dataset.o);
+    if ((el.classList.contains("b") && start <= o && o < end) || o === start) {
+      el.classList.add("hl");
+      marked.push(el);
+    }
+  });
+});
+</script>
+</body>
+</html>
+`
+
+var html_classes = map[string]string{
+	"char":        "ch",
+	"unprintable": "invalid",
+	"bom":         "bom",
+	"escape":      "esc",
+	"variant":     "variant",
+	"control":     "ctrl",
+	"padding":     "pad",
+}
+
+// htmlwriter renders the layout as a standalone HTML page. The colors of the
+// text output become CSS classes and every byte and character knows its
+// offset, so that hovering a character highlights its bytes.
+type htmlwriter struct {
+	*row_splitter
+	output io.Writer
+	title  string
+	count  int
+	// last is the last character of the previous row in each column
+	last []trace_item
+}
+
+// hex_cells wraps the bytes of a hex column in spans with their offset.
+func (h *htmlwriter) hex_cells(col column, txt string, row row_data) string {
+	width := uint64(h.layout.width)
+	row_start := row.offset - row.offset%width
+	first := int(row.offset % width)
+	owner := map[int]int{}
+	for idx := first; idx < first+len(row.data); idx++ {
+		start, n := h.layout.byte_cells(col, idx, nil)
+		for cell := start; cell < start+n; cell++ {
+			owner[cell] = idx
+		}
+	}
+	var sb strings.Builder
+	cell := 0
+	for len(txt) > 0 {
+		idx, ok := owner[cell]
+		if !ok {
+			r, size := utf8.DecodeRuneInString(txt)
+			sb.WriteString(html.EscapeString(txt[:size]))
+			cell += runeWidth(r)
+			txt = txt[size:]
+			continue
+		}
+		_, n := h.layout.byte_cells(col, idx, nil)
+		n = min(n, len(txt))
+		fmt.Fprintf(&sb, `<span class="b" data-o="%d">%s</span>`, row_start+uint64(idx), html.EscapeString(txt[:n]))
+		cell += n
+		txt = txt[n:]
+	}
+	return sb.String()
+}
+
+// chars wraps the characters of a printable column in spans with the offset
+// and size of their bytes. "_" shown for the rest of a character is marked as
+// padding.
+func (h *htmlwriter) chars(col column, idx int, txt string, row row_data) string {
+	width := uint64(h.layout.width)
+	row_start := row.offset - row.offset%width
+	type span struct {
+		item  trace_item
+		start int
+		end   int
+	}
+	spans := []span{}
+	if prev := h.last[idx]; prev.Size != 0 && prev.Offset+uint64(prev.Size) > row_start {
+		spans = append(spans, span{prev, -1, int(prev.Offset + uint64(prev.Size) - row_start)})
+	}
+	// a control shown in more cells than its bytes moves the rest of the row
+	shift := 0
+	for _, item := range row.chars[idx] {
+		pos := int(item.Offset - row_start)
+		size := min(item.Size, int(width)-pos)
+		start := pos + shift
+		spans = append(spans, span{item, start, start + max(size, textWidth(item.Text))})
+		if item.Kind == "control" {
+			shift += max(0, textWidth(item.Text)-item.Size)
+		}
+	}
+	if n := len(row.chars[idx]); n != 0 {
+		h.last[idx] = row.chars[idx][n-1]
+	}
+	var sb strings.Builder
+	cell := 0
+	if col.start_ch != "" && strings.HasPrefix(txt, col.start_ch) {
+		sb.WriteString(html.EscapeString(col.start_ch))
+		txt = txt[len(col.start_ch):]
+	}
+	for len(txt) > 0 {
+		r, size := utf8.DecodeRuneInString(txt)
+		class := ""
+		var item trace_item
+		for _, s := range spans {
+			if s.start <= cell && cell < s.end {
+				item = s.item
+				class = "pad"
+				if s.start >= 0 && cell < s.start+textWidth(item.Text) {
+					class = html_classes[item.Kind]
+				}
+				break
+			}
+		}
+		if class == "" {
+			sb.WriteString(html.EscapeString(txt[:size]))
+		} else {
+			fmt.Fprintf(&sb, `<span class="%s" data-o="%d" data-n="%d">%s</span>`,
+				class, item.Offset, item.Size, html.EscapeString(txt[:size]))
+		}
+		cell += runeWidth(r)
+		txt = txt[size:]
+	}
+	return sb.String()
+}
+
+func (h *htmlwriter) emit(row row_data) error {
+	if h.count == 0 {
+		if _, err := fmt.Fprintf(h.output, html_head, html.EscapeString(h.title)); err != nil {
+			return err
+		}
+	}
+	h.count++
+	var sb strings.Builder
+	for idx, col := range h.layout.columns {
+		txt := ansiEscape.ReplaceAllString(row.texts[idx], "")
+		switch col.name {
+		case "header":
+			sb.WriteString(`<span class="addr">` + html.EscapeString(txt) + `</span>`)
+		case "hexdump", "hexbytes", "octal", "decimal", "binary":
+			sb.WriteString(h.hex_cells(col, txt, row))
+		case "printable":
+			sb.WriteString(h.chars(col, idx, txt, row))
+		default:
+			sb.WriteString(html.EscapeString(txt))
+		}
+		if pad := col.width - textWidth(txt); pad > 0 && idx != len(h.layout.columns)-1 {
+			sb.WriteString(strings.Repeat(" ", pad))
+		}
+	}
+	_, err := fmt.Fprintln(h.output, sb.String())
+	return err
+}
+
+func (h *htmlwriter) Close() error {
+	if err := h.row_splitter.Close(); err != nil {
+		return err
+	}
+	if h.count == 0 {
+		if _, err := fmt.Fprintf(h.output, html_head, html.EscapeString(h.title)); err != nil {
+			return err
+		}
+	}
+	_, err := fmt.Fprint(h.output, html_tail)
+	return err
+}
+
+// newHTMLWriter creates an htmlwriter for layout. title is shown as the page
+// title.
+func newHTMLWriter(output io.Writer, layout *Layout, title string) *htmlwriter {
+	h := &htmlwriter{
+		output: output,
+		title:  title,
+		last:   make([]trace_item, len(layout.columns)),
+	}
+	h.row_splitter = newRowSplitter(layout, h.emit)
+	return h
+}
@@ -0,0 +1,42 @@
+package uhd
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+)
+
+//nolint:gosmopolitan
+func TestHTMLWriter(t *testing.T) {
+	layout, err := ParseLayout("header,hexdump_lower,printable_pipe", "utf-8", 4, 8)
+	if err != nil {
+		t.Fatal("ParseLayout", "err", err)
+	}
+	buf := &bytes.Buffer{}
+	hw := newHTMLWriter(buf, layout, "<test>")
+	if _, err := hw.Write([]byte("a<\xe3\x81\x82\xff")); err != nil {
+		t.Fatal("write", "err", err)
+	}
+	if err := hw.Close(); err != nil {
+		t.Fatal("close", "err", err)
+	}
+	out := buf.String()
+	if !strings.HasPrefix(out, "<!DOCTYPE html>") || !strings.HasSuffix(out, "</html>\n") {
+		t.Errorf("not a html page: %q", out)
+	}
+	if !strings.Contains(out, "<title>&lt;test&gt;</title>") {
+		t.Errorf("title not escaped: %q", out)
+	}
+	expected := `<span class="addr">00000000</span>  ` +
+		`<span class="b" data-o="0">61</span> <span class="b" data-o="1">3c</span> ` +
+		`<span class="b" data-o="2">e3</span> <span class="b" data-o="3">81</span> ` +
+		`|<span class="ch" data-o="0" data-n="1">a</span><span class="ch" data-o="1" data-n="1">&lt;</span>` +
+		`<span class="ch" data-o="2" data-n="3">あ</span>|` + "\n" +
+		`<span class="addr">00000004</span>  ` +
+		`<span class="b" data-o="4">82</span> <span class="b" data-o="5">ff</span>       ` +
+		`|<span class="pad" data-o="2" data-n="3">_</span><span class="invalid" data-o="5" data-n="1">.</span>` + "\n"
+	body := out[strings.Index(out, "<pre class=\"uhd\">\n")+len("<pre class=\"uhd\">\n") : strings.Index(out, "</pre>")]
+	if body != expected {
+		t.Errorf("unexpected output:\ngot:  %q\nwant: %q", body, expected)
+	}
+}
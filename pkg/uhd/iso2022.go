@@ -0,0 +1,273 @@
+package uhd
+
+import (
+	"bytes"
+	"fmt"
+	"strings"
+
+	"github.com/fatih/color"
+	"golang.org/x/text/encoding/japanese"
+	"golang.org/x/text/encoding/korean"
+	"golang.org/x/text/encoding/simplifiedchinese"
+)
+
+// iso2022_designation is a graphic set (G0-G3) and the character set an
+// escape sequence puts into it.
+type iso2022_designation struct {
+	g   int
+	set string
+}
+
+// iso2022_designations maps the bytes after ESC to what they designate.
+// "cns11643-*" are tracked but shown as unprintable: there is no decoder for
+// them in x/text.
+var iso2022_designations = map[string]iso2022_designation{
+	"(B":  {0, "ascii"},
+	"(J":  {0, "jisx0201-roman"},
+	"(I":  {0, "jisx0201-kana"},
+	"$@":  {0, "jisx0208"},
+	"$B":  {0, "jisx0208"},
+	"$A":  {0, "gb2312"},
+	"$(B": {0, "jisx0208"},
+	"$(C": {0, "ksc5601"},
+	"$(D": {0, "jisx0212"},
+	"$)A": {1, "gb2312"},
+	"$)C": {1, "ksc5601"},
+	"$)G": {1, "cns11643-1"},
+	"$*H": {2, "cns11643-2"},
+	"$+I": {3, "cns11643-3"},
+	"$+J": {3, "cns11643-4"},
+	"$+K": {3, "cns11643-5"},
+	"$+L": {3, "cns11643-6"},
+	"$+M": {3, "cns11643-7"},
+}
+
+// iso2022 is the state of an ISO-2022 decoder: the character sets in G0-G3,
+// the SO/SI shift and a pending single shift (SS2/SS3).
+type iso2022 struct {
+	variant string
+	g       [4]string
+	shift   bool
+	single  int
+}
+
+// iso2022_variant returns "jp", "kr" or "cn" for the name of an ISO-2022
+// encoding, or "" for other encodings.
+func iso2022_variant(name string) string {
+	switch strings.ToLower(name) {
+	case "iso-2022-jp", "iso2022jp", "csiso2022jp", "jis":
+		return "jp"
+	case "iso-2022-kr", "iso2022kr", "csiso2022kr":
+		return "kr"
+	case "iso-2022-cn", "iso2022cn", "csiso2022cn":
+		return "cn"
+	}
+	return ""
+}
+
+func new_iso2022(variant string) *iso2022 {
+	res := &iso2022{variant: variant}
+	res.g[0] = "ascii"
+	if variant == "kr" {
+		// "ESC $ ) C" is written once at the top, so assume it for the
+		// rows of the middle of a file.
+		res.g[1] = "ksc5601"
+	}
+	return res
+}
+
+// newline resets the state at the end of a line: RFC 1557 starts lines of
+// ISO-2022-KR in ASCII and RFC 1922 repeats the designations of ISO-2022-CN
+// on each line.
+func (s *iso2022) newline() {
+	switch s.variant {
+	case "kr":
+		s.shift = false
+	case "cn":
+		s.shift = false
+		s.g[1], s.g[2], s.g[3] = "", "", ""
+	}
+}
+
+// current returns the character set of the next graphic character.
+func (s *iso2022) current() string {
+	switch {
+	case s.single != 0:
+		return s.g[s.single]
+	case s.shift:
+		return s.g[1]
+	}
+	return s.g[0]
+}
+
+func iso2022_size(set string) int {
+	switch set {
+	case "", "ascii", "jisx0201-roman", "jisx0201-kana":
+		return 1
+	}
+	return 2
+}
+
+// iso2022_decode returns the character of the 7-bit bytes b in set, or "" if
+// it is not a valid or printable character.
+func iso2022_decode(set string, b []byte) string {
+	switch set {
+	case "ascii":
+		return string(rune(b[0]))
+	case "jisx0201-roman":
+		switch b[0] {
+		case 0x5c:
+			return "¥"
+		case 0x7e:
+			return "‾"
+		}
+		return string(rune(b[0]))
+	case "jisx0201-kana":
+		if b[0] <= 0x5f {
+			return string(rune(0xff61 + int(b[0]) - 0x21))
+		}
+	case "jisx0208":
+		if valid_eucjp(b[0]|0x80, b[1]|0x80) {
+			return decode_char(japanese.EUCJP, []byte{b[0] | 0x80, b[1] | 0x80})
+		}
+	case "jisx0212":
+		return decode_char(japanese.EUCJP, []byte{0x8f, b[0] | 0x80, b[1] | 0x80})
+	case "ksc5601":
+		if valid_euckr(b[0]|0x80, b[1]|0x80) {
+			return decode_char(korean.EUCKR, []byte{b[0] | 0x80, b[1] | 0x80})
+		}
+	case "gb2312":
+		if valid_euccn(b[0]|0x80, b[1]|0x80) {
+			return decode_char(simplifiedchinese.GB18030, []byte{b[0] | 0x80, b[1] | 0x80})
+		}
+	}
+	return ""
+}
+
+// iso2022_resync returns the position in p from where decoding reaches the
+// same state as decoding all of p: the last escape sequence, or for
+// ISO-2022-KR/CN the last line feed if it comes later. It returns 0 if there
+// is neither, and len(p) for other encodings.
+func iso2022_resync(name string, p []byte) int {
+	variant := iso2022_variant(name)
+	if variant == "" {
+		return len(p)
+	}
+	res := max(0, bytes.LastIndexByte(p, 0x1b))
+	if variant != "jp" {
+		res = max(res, bytes.LastIndexByte(p, '\n')+1)
+	}
+	return res
+}
+
+// marker shows the control bytes of a shift or an escape sequence, one cell
+// per byte and wrapped at the end of the row like the bytes they stand for.
+func (h *Printable) marker(s string) {
+	for len(s) > 0 {
+		n := min(len(s), h.width-int(h.cur%uint64(h.width)))
+		h.trace.add("escape", s[:n], n)
+		fmt.Fprint(h.output, color.MagentaString(s[:n]))
+		h.cur += uint64(n)
+		s = s[n:]
+		if h.cur%uint64(h.width) == 0 {
+			h.text(h.end_ch + "\n")
+			if len(s) > 0 {
+				h.text(h.start_ch)
+			}
+		}
+	}
+}
+
+// length returns the length of the escape sequence, shift or character
+// at the top of p, or 0 if p ends in the middle of it.
+func (s *iso2022) length(p []byte) int {
+	switch {
+	case p[0] == 0x1b:
+		end := 1
+		for end < len(p) && end < 4 && in_range(p[end], 0x20, 0x2f) {
+			end++
+		}
+		if end == len(p) && end < 4 {
+			return 0
+		}
+		if end == 4 || !in_range(p[end], 0x30, 0x7e) {
+			return 1
+		}
+		return end + 1
+	case in_range(p[0], 0x21, 0x7e):
+		size := iso2022_size(s.current())
+		if len(p) < size {
+			return 0
+		}
+		if size == 2 && !in_range(p[1], 0x21, 0x7e) {
+			return 1
+		}
+		return size
+	}
+	return 1
+}
+
+// iso2022_next shows the escape sequence, shift or character at the top of
+// p and returns its length, or 0 if p ends in the middle of it.
+func (h *Printable) iso2022_next(p []byte) int {
+	st := h.iso
+	size := st.length(p)
+	if size == 0 {
+		return 0
+	}
+	if h.cur%uint64(h.width) == 0 {
+		h.text(h.start_ch)
+	}
+	switch ch := p[0]; {
+	case ch == 0x1b && size > 1:
+		seq := string(p[1:size])
+		if d, ok := iso2022_designations[seq]; ok {
+			st.g[d.g] = d.set
+		}
+		switch seq {
+		case "N":
+			st.single = 2
+		case "O":
+			st.single = 3
+		}
+		h.marker("^" + seq)
+	case ch == 0x0e:
+		st.shift = true
+		h.marker("{")
+	case ch == 0x0f:
+		st.shift = false
+		h.marker("}")
+	case ch == ' ':
+		h.put(" ", 1)
+	case in_range(ch, 0x21, 0x7e):
+		set := st.current()
+		st.single = 0
+		if size != iso2022_size(set) {
+			h.put("", size)
+		} else {
+			h.put(iso2022_decode(set, p[:size]), size)
+		}
+	default:
+		if ch == '\n' {
+			st.newline()
+		}
+		h.put_control(rune(ch), 1)
+	}
+	return size
+}
+
+func (h *Printable) writeISO2022(p []byte, variant string) (n int, err error) {
+	if h.iso == nil {
+		h.iso = new_iso2022(variant)
+	}
+	buf := append(h.rest, p...)
+	for len(buf) > 0 {
+		size := h.iso2022_next(buf)
+		if size == 0 {
+			break
+		}
+		buf = buf[size:]
+	}
+	h.rest = bytes.Clone(buf)
+	return len(p), nil
+}
@@ -0,0 +1,77 @@
+package uhd
+
+import (
+	"bufio"
+	"os"
+	"strconv"
+	"strings"
+	"testing"
+	"unicode"
+	"unicode/utf8"
+)
+
+//nolint:gosmopolitan
+func TestDecodeEUCJIS2004(t *testing.T) {
+	// code points of the JIS X 0213 tables of x0213.org
+	tests := []struct {
+		input    []byte
+		expected string
+	}{
+		{[]byte{0xa1, 0xc1}, "〜"},
+		{[]byte{0xa2, 0xc2}, "⊄"},
+		{[]byte{0xa4, 0xf7}, "か\u309a"},
+		{[]byte{0xad, 0xa1}, "①"},
+		{[]byte{0xae, 0xa2}, "\U0002000b"},
+		{[]byte{0xcf, 0xd4}, "\U00020b9f"},
+		{[]byte{0x8f, 0xa1, 0xa1}, "\U00020089"},
+		{[]byte{0x8f, 0xfe, 0xf6}, "\U0002a6b2"},
+	}
+	for _, tt := range tests {
+		if got := decode_eucjis2004(tt.input); got != tt.expected {
+			t.Errorf("unexpected output of % x:\ngot:  %q\nwant: %q", tt.input, got, tt.expected)
+		}
+	}
+}
+
+func TestDecodeEUCJIS2004_mapping(t *testing.T) {
+	// every code of the mapping the tables are generated from
+	fp, err := os.Open("testdata/euc-jis-2004.txt")
+	if err != nil {
+		t.Fatal("open", "err", err)
+	}
+	defer fp.Close()
+	scanner := bufio.NewScanner(fp)
+	for scanner.Scan() {
+		fields := strings.Fields(scanner.Text())
+		if len(fields) != 2 || strings.HasPrefix(fields[0], "#") {
+			continue
+		}
+		code, err := strconv.ParseUint(strings.TrimPrefix(fields[0], "0x"), 16, 32)
+		if err != nil {
+			t.Fatal("code", "err", err)
+		}
+		input := []byte{byte(code >> 8), byte(code)}
+		if code > 0xffff {
+			if jisx0213_plane2[byte(code>>8)-0xa0] == nil {
+				// JIS X 0212, which CPython falls back to
+				continue
+			}
+			input = []byte{0x8f, byte(code >> 8), byte(code)}
+		}
+		var sb strings.Builder
+		for _, cp := range strings.Split(strings.TrimPrefix(fields[1], "U+"), "+") {
+			r, _ := strconv.ParseUint(cp, 16, 32)
+			sb.WriteRune(rune(r))
+		}
+		if r, _ := utf8.DecodeRuneInString(sb.String()); !unicode.IsPrint(r) {
+			// shown as unprintable, like U+3000
+			continue
+		}
+		if got := decode_eucjis2004(input); got != sb.String() {
+			t.Errorf("unexpected output of %s:\ngot:  %q\nwant: %q", fields[0], got, sb.String())
+		}
+	}
+	if err := scanner.Err(); err != nil {
+		t.Fatal("scan", "err", err)
+	}
+}
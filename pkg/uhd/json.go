@@ -0,0 +1,90 @@
+package uhd
+
+import (
+	"bytes"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io"
+	"strings"
+)
+
+type json_column struct {
+	Encoding string       `json:"encoding"`
+	Chars    []trace_item `json:"chars"`
+}
+
+type json_row struct {
+	Offset    uint64        `json:"offset"`
+	Bytes     []byte        `json:"bytes"`
+	Hex       string        `json:"hex"`
+	Printable []json_column `json:"printable"`
+}
+
+// jsonwriter writes one object per row, as a JSON array or as JSON Lines.
+// The characters come from the printable columns of the layout, so they are
+// decoded exactly as in the text output.
+type jsonwriter struct {
+	*row_splitter
+	output io.Writer
+	lines  bool
+	count  int
+}
+
+func (j *jsonwriter) emit(row row_data) error {
+	res := json_row{
+		Offset:    row.offset,
+		Bytes:     row.data,
+		Hex:       hex.EncodeToString(row.data),
+		Printable: []json_column{},
+	}
+	for idx, col := range j.layout.columns {
+		if col.name == "printable" {
+			res.Printable = append(res.Printable, json_column{col.encoding, row.chars[idx]})
+		}
+	}
+	buf := &bytes.Buffer{}
+	enc := json.NewEncoder(buf)
+	enc.SetEscapeHTML(false)
+	if err := enc.Encode(res); err != nil {
+		return err
+	}
+	line := strings.TrimRight(buf.String(), "\n")
+	var err error
+	switch {
+	case j.lines:
+		_, err = fmt.Fprintln(j.output, line)
+	case j.count == 0:
+		_, err = fmt.Fprint(j.output, "[\n"+line)
+	default:
+		_, err = fmt.Fprint(j.output, ",\n"+line)
+	}
+	j.count++
+	return err
+}
+
+func (j *jsonwriter) Close() error {
+	if err := j.row_splitter.Close(); err != nil {
+		return err
+	}
+	if j.lines {
+		return nil
+	}
+	if j.count == 0 {
+		_, err := fmt.Fprintln(j.output, "[]")
+		return err
+	}
+	_, err := fmt.Fprintln(j.output, "\n]")
+	return err
+}
+
+// newJSONWriter creates a jsonwriter for layout. lines selects JSON Lines
+// instead of a JSON array.
+func newJSONWriter(output io.Writer, layout *Layout, lines bool) *jsonwriter {
+	j := &jsonwriter{
+		output: output,
+		lines:  lines,
+	}
+	j.row_splitter = newRowSplitter(layout, j.emit)
+	return j
+}
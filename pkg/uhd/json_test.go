@@ -0,0 +1,125 @@
+package uhd
+
+import (
+	"bytes"
+	"encoding/json"
+	"slices"
+	"strings"
+	"testing"
+)
+
+//nolint:gosmopolitan
+func TestJSONWriter(t *testing.T) {
+	layout, err := ParseLayout("header,hexdump,printable", "utf-8,shift-jis", 4, 8)
+	if err != nil {
+		t.Fatal("ParseLayout", "err", err)
+	}
+	buf := &bytes.Buffer{}
+	jw := newJSONWriter(buf, layout, true)
+	// "aaa" + "あ" in utf-8 + invalid bytes
+	if _, err := jw.Write([]byte("aaa\xe3\x81\x82\x82\xa0\xff")); err != nil {
+		t.Fatal("write", "err", err)
+	}
+	if err := jw.Close(); err != nil {
+		t.Fatal("close", "err", err)
+	}
+	lines := strings.Split(strings.TrimSpace(buf.String()), "\n")
+	if len(lines) != 3 {
+		t.Fatalf("unexpected rows: %q", buf.String())
+	}
+	rows := make([]json_row, len(lines))
+	for idx, line := range lines {
+		if err := json.Unmarshal([]byte(line), &rows[idx]); err != nil {
+			t.Fatal("unmarshal", "line", line, "err", err)
+		}
+	}
+	if rows[1].Offset != 4 || rows[1].Hex != "818282a0" || !bytes.Equal(rows[1].Bytes, []byte{0x81, 0x82, 0x82, 0xa0}) {
+		t.Errorf("unexpected row: %+v", rows[1])
+	}
+	utf8chars := []trace_item{}
+	sjischars := []trace_item{}
+	for _, row := range rows {
+		if len(row.Printable) != 2 {
+			t.Fatalf("unexpected columns: %+v", row)
+		}
+		utf8chars = append(utf8chars, row.Printable[0].Chars...)
+		sjischars = append(sjischars, row.Printable[1].Chars...)
+	}
+	expected := []trace_item{
+		{"a", 0, 1, "char", false},
+		{"a", 1, 1, "char", false},
+		{"a", 2, 1, "char", false},
+		{"あ", 3, 3, "char", true},
+		{".", 6, 1, "unprintable", false},
+		{".", 7, 1, "unprintable", false},
+		{".", 8, 1, "unprintable", false},
+	}
+	if !slices.Equal(utf8chars, expected) {
+		t.Errorf("unexpected utf-8 chars:\ngot:  %+v\nwant: %+v", utf8chars, expected)
+	}
+	// E3 81 is a wide character at the end of the first row
+	if idx := slices.IndexFunc(sjischars, func(c trace_item) bool { return c.Offset == 3 }); idx == -1 {
+		t.Errorf("shift-jis char not found: %+v", sjischars)
+	} else if c := sjischars[idx]; c.Text != "縺" || c.Size != 2 || !c.Padded {
+		t.Errorf("unexpected shift-jis char: %+v", c)
+	}
+}
+
+func TestJSONWriter_cross(t *testing.T) {
+	layout, err := ParseLayout("printable", "utf-8", 4, 8)
+	if err != nil {
+		t.Fatal("ParseLayout", "err", err)
+	}
+	buf := &bytes.Buffer{}
+	jw := newJSONWriter(buf, layout, true)
+	// "€" takes one cell at the end of the first row, and its size is all of
+	// its bytes
+	if _, err := jw.Write([]byte("ab\u20acx")); err != nil {
+		t.Fatal("write", "err", err)
+	}
+	if err := jw.Close(); err != nil {
+		t.Fatal("close", "err", err)
+	}
+	var row json_row
+	if err := json.Unmarshal([]byte(strings.Split(buf.String(), "\n")[0]), &row); err != nil {
+		t.Fatal("unmarshal", "output", buf.String(), "err", err)
+	}
+	expected := trace_item{"\u20ac", 2, 3, "char", true}
+	if c := row.Printable[0].Chars; len(c) != 3 || c[2] != expected {
+		t.Errorf("unexpected chars: %+v", c)
+	}
+}
+
+func TestJSONWriter_Array(t *testing.T) {
+	layout, err := ParseLayout("hexdump", "utf-8", 4, 8)
+	if err != nil {
+		t.Fatal("ParseLayout", "err", err)
+	}
+	buf := &bytes.Buffer{}
+	jw := newJSONWriter(buf, layout, false)
+	jw.SetOffset(2)
+	if _, err := jw.Write([]byte("abc")); err != nil {
+		t.Fatal("write", "err", err)
+	}
+	if err := jw.Close(); err != nil {
+		t.Fatal("close", "err", err)
+	}
+	var rows []json_row
+	if err := json.Unmarshal(buf.Bytes(), &rows); err != nil {
+		t.Fatal("unmarshal", "output", buf.String(), "err", err)
+	}
+	if len(rows) != 2 || rows[0].Offset != 2 || rows[0].Hex != "6162" || rows[1].Offset != 4 || rows[1].Hex != "63" {
+		t.Errorf("unexpected rows: %+v", rows)
+	}
+	if c := rows[1].Printable[0].Chars; len(c) != 1 || c[0].Offset != 4 {
+		t.Errorf("unexpected chars: %+v", c)
+	}
+	buf.Reset()
+	jw = newJSONWriter(buf, layout, false)
+	if err := jw.Close(); err != nil {
+		t.Fatal("close", "err", err)
+	}
+	if buf.String() != "[]\n" {
+		t.Errorf("unexpected output:\ngot:  %q\nwant: %q", buf.String(), "[]\n")
+	}
+}
@@ -0,0 +1,619 @@
+package uhd
+
+import (
+	"bytes"
+	"cmp"
+	"fmt"
+	"io"
+	"slices"
+	"strconv"
+	"strings"
+	"unicode/utf8"
+)
+
+type column struct {
+	name     string
+	width    int
+	encoding string
+	lower    bool
+	start_ch string
+	end_ch   string
+	// components shows the code points of grapheme clusters one by one
+	components bool
+	// control is the style of the C0 controls and DEL, "" for dots
+	control string
+	// kind, word and big are the values of a number column: words of word
+	// bytes, or of sep bytes if 0, in big endian if big is set
+	kind byte
+	word int
+	big  bool
+	// group is the number of bits between the "_" of a binary column
+	group int
+	// address is the radix of a header column, "" for hex, and digits the
+	// digits of its addresses if more than 8
+	address string
+	digits  int
+}
+
+// column_aliases maps the short column names to the column and its default
+// parameters.
+var column_aliases = map[string]column{
+	"header":         {name: "header"},
+	"header_lower":   {name: "header", lower: true},
+	"hexdump":        {name: "hexdump"},
+	"hexdump_lower":  {name: "hexdump", lower: true},
+	"hexbytes":       {name: "hexbytes"},
+	"hexbytes_lower": {name: "hexbytes", lower: true},
+	"printable":      {name: "printable"},
+	"printable_pipe": {name: "printable", start_ch: "|", end_ch: "|"},
+	"octal":          {name: "octal"},
+	"decimal":        {name: "decimal"},
+	"binary":         {name: "binary"},
+	"uint":           {name: "number", kind: NumberUint},
+	"int":            {name: "number", kind: NumberInt},
+	"float":          {name: "number", kind: NumberFloat},
+	"u8":             {name: "number", kind: NumberUint, word: 1},
+	"i8":             {name: "number", kind: NumberInt, word: 1},
+	"u16le":          {name: "number", kind: NumberUint, word: 2},
+	"u16be":          {name: "number", kind: NumberUint, word: 2, big: true},
+	"i16le":          {name: "number", kind: NumberInt, word: 2},
+	"i16be":          {name: "number", kind: NumberInt, word: 2, big: true},
+	"u32le":          {name: "number", kind: NumberUint, word: 4},
+	"u32be":          {name: "number", kind: NumberUint, word: 4, big: true},
+	"i32le":          {name: "number", kind: NumberInt, word: 4},
+	"i32be":          {name: "number", kind: NumberInt, word: 4, big: true},
+	"u64le":          {name: "number", kind: NumberUint, word: 8},
+	"u64be":          {name: "number", kind: NumberUint, word: 8, big: true},
+	"i64le":          {name: "number", kind: NumberInt, word: 8},
+	"i64be":          {name: "number", kind: NumberInt, word: 8, big: true},
+	"f32le":          {name: "number", kind: NumberFloat, word: 4},
+	"f32be":          {name: "number", kind: NumberFloat, word: 4, big: true},
+	"f64le":          {name: "number", kind: NumberFloat, word: 8},
+	"f64be":          {name: "number", kind: NumberFloat, word: 8, big: true},
+}
+
+var predefined_layouts = map[string]string{
+	"jhd":     "header,hexdump,printable",
+	"hexdump": "header,hexdump_lower,printable_pipe",
+	"bytes":   "header,hexbytes_lower,printable",
+}
+
+// Layout is a list of columns rendered for rows of width bytes. The hex
+// columns have an extra space every sep bytes.
+type Layout struct {
+	columns []column
+	width   int
+	sep     int
+	// base is added to the addresses shown, and limit is the size of the
+	// input the address columns are widened for
+	base  uint64
+	limit uint64
+}
+
+func column_width(col column, width, sep int) int {
+	switch col.name {
+	case "header":
+		if col.address == "none" {
+			return 0
+		}
+		return max(8, col.digits) + 1
+	case "hexdump":
+		return 3*width + width/sep + (width / 8) + 1
+	case "hexbytes":
+		return 6*width + 1
+	case "printable":
+		return width*control_cells(col.control) + len(col.start_ch) + len(col.end_ch)
+	case "octal", "decimal", "binary":
+		return (byte_digits(col)+1)*width + width/sep + 1
+	case "number":
+		return width/col.word*(number_width(col.kind, col.word)+1) + 1
+	}
+	return 0
+}
+
+// parse_column parses one column spec "name[:param[:param...]]".
+// A param is "key=value" or a bare value: "lower"/"upper" set the case,
+// "components" shows the code points of grapheme clusters one by one, "le"
+// and "be" set the byte order of a number column, "hex", "dec", "oct" and
+// "none" the radix of a header column and anything else is the encoding of a
+// printable column. "control=caret" sets the style of the controls in a
+// printable column.
+func parse_column(spec string) (column, error) {
+	tok := strings.Split(strings.TrimSpace(spec), ":")
+	col, ok := column_aliases[strings.ToLower(tok[0])]
+	if !ok {
+		return col, fmt.Errorf("unknown column: %q", tok[0])
+	}
+	for _, param := range tok[1:] {
+		key, val, found := strings.Cut(param, "=")
+		if !found {
+			switch strings.ToLower(param) {
+			case "lower", "upper":
+				key, val = "case", param
+			case "pipe":
+				key, val = "delim", "|"
+			case "components":
+				key, val = "graphemes", "components"
+			case "le", "be":
+				key, val = "endian", param
+			case "hex", "dec", "oct", "none":
+				key, val = "format", param
+			default:
+				key, val = "encoding", param
+			}
+		}
+		switch strings.ToLower(key) {
+		case "encoding", "enc":
+			if col.name != "printable" {
+				return col, fmt.Errorf("column %s does not take an encoding: %q", col.name, param)
+			}
+			col.encoding = val
+		case "case":
+			switch strings.ToLower(val) {
+			case "lower":
+				col.lower = true
+			case "upper":
+				col.lower = false
+			default:
+				return col, fmt.Errorf("invalid case: %q", val)
+			}
+		case "graphemes":
+			if col.name != "printable" {
+				return col, fmt.Errorf("column %s does not take graphemes: %q", col.name, param)
+			}
+			switch strings.ToLower(val) {
+			case "clusters":
+				col.components = false
+			case "components":
+				col.components = true
+			default:
+				return col, fmt.Errorf("invalid graphemes: %q", val)
+			}
+		case "control":
+			if col.name != "printable" {
+				return col, fmt.Errorf("column %s does not take a control style: %q", col.name, param)
+			}
+			col.control = strings.ToLower(val)
+			if !valid_control_style(col.control) {
+				return col, fmt.Errorf("invalid control style: %q", val)
+			}
+		case "endian":
+			if col.name != "number" {
+				return col, fmt.Errorf("column %s does not take an endian: %q", col.name, param)
+			}
+			switch strings.ToLower(val) {
+			case "le", "little":
+				col.big = false
+			case "be", "big":
+				col.big = true
+			default:
+				return col, fmt.Errorf("invalid endian: %q", val)
+			}
+		case "format":
+			if col.name != "header" {
+				return col, fmt.Errorf("column %s does not take an address format: %q", col.name, param)
+			}
+			col.address = strings.ToLower(val)
+			if !slices.Contains(address_formats, col.address) {
+				return col, fmt.Errorf("invalid address format: %q", val)
+			}
+		case "group":
+			if col.name != "binary" {
+				return col, fmt.Errorf("column %s does not take a bit group: %q", col.name, param)
+			}
+			group, err := strconv.Atoi(val)
+			if err != nil || group < 1 || group > 8 {
+				return col, fmt.Errorf("invalid bit group: %q", val)
+			}
+			col.group = group
+		case "start":
+			col.start_ch = val
+		case "end":
+			col.end_ch = val
+		case "delim":
+			col.start_ch = val
+			col.end_ch = val
+		default:
+			return col, fmt.Errorf("unknown parameter for %s: %q", col.name, key)
+		}
+	}
+	return col, nil
+}
+
+// get_layout returns the columns of a predefined layout name or of a
+// comma-separated column list. A printable column without an explicit
+// encoding is repeated for each of the comma-separated encodings.
+func get_layout(spec string, encoding string, width, sep int) ([]column, error) {
+	if predefined, ok := predefined_layouts[spec]; ok {
+		spec = predefined
+	}
+	res := make([]column, 0)
+	for colspec := range strings.SplitSeq(spec, ",") {
+		col, err := parse_column(colspec)
+		if err != nil {
+			return nil, err
+		}
+		encodings := []string{col.encoding}
+		if col.name == "printable" && col.encoding == "" {
+			encodings = strings.Split(encoding, ",")
+		}
+		for _, enc := range encodings {
+			col.encoding = strings.TrimSpace(enc)
+			if col.name == "printable" && col.encoding != "auto" && !ValidEncoding(col.encoding) {
+				return nil, fmt.Errorf("unknown encoding: %q", col.encoding)
+			}
+			if col.name == "number" {
+				if col.word == 0 {
+					col.word = sep
+				}
+				if !valid_word(col.kind, col.word) {
+					return nil, fmt.Errorf("invalid word size of %s: %d", strings.TrimSpace(colspec), col.word)
+				}
+				if width%col.word != 0 {
+					return nil, fmt.Errorf("width %d is not a multiple of the word size of %s: %d", width, strings.TrimSpace(colspec), col.word)
+				}
+			}
+			col.width = column_width(col, width, sep)
+			res = append(res, col)
+		}
+	}
+	// keep a gap between a printable column and the next column
+	for idx := range len(res) - 1 {
+		if res[idx].name == "printable" {
+			res[idx].width += 1
+		}
+	}
+	return res, nil
+}
+
+// ParseLayout returns the layout of a predefined name ("jhd", "hexdump",
+// "bytes") or of a column list such as "header,hexdump_lower,printable:sjis".
+// encoding is a comma-separated list of encodings for the printable columns
+// without one; "auto" is resolved later by ResolveAuto.
+func ParseLayout(spec, encoding string, width, sep int) (*Layout, error) {
+	if width <= 0 || sep <= 0 {
+		return nil, fmt.Errorf("invalid width or sep: %d, %d", width, sep)
+	}
+	columns, err := get_layout(spec, encoding, width, sep)
+	if err != nil {
+		return nil, err
+	}
+	return &Layout{columns: columns, width: width, sep: sep}, nil
+}
+
+// ShowComponents shows the code points of grapheme clusters one by one in the
+// UTF-8 printable columns.
+func (l *Layout) ShowComponents() {
+	for idx := range l.columns {
+		l.columns[idx].components = true
+	}
+}
+
+// Width returns the number of bytes in a row.
+func (l *Layout) Width() int {
+	return l.width
+}
+
+// SqueezeLine returns the line that stands for rows squeezed away, size bytes
+// in total, as a dump with counts shows it: "* (N rows, 0xNNNN bytes of XX)".
+// fill is the byte all of them are filled with, or -1.
+func (l *Layout) SqueezeLine(rows int, size uint64, fill int) string {
+	return squeeze_count(rows, size, fill)
+}
+
+// FormatAddress returns offset as the first header column shows it, with the
+// base address added, or in hex if no column shows the addresses.
+func (l *Layout) FormatAddress(offset uint64) string {
+	for _, col := range l.columns {
+		if col.name == "header" && col.address != "none" {
+			return format_address(l.base+offset, cmp.Or(col.address, "hex"), max(8, col.digits), col.lower)
+		}
+	}
+	return format_address(l.base+offset, "hex", 8, false)
+}
+
+type offsetter interface {
+	SetOffset(offset uint64)
+}
+
+// new_writer returns the column writer for col that renders into output.
+func (l *Layout) new_writer(col column, output io.Writer) io.Writer {
+	switch col.name {
+	case "header":
+		hdr := NewHeader(output, l.width)
+		if col.lower {
+			hdr = NewHeaderLower(output, l.width)
+		}
+		hdr.format = cmp.Or(col.address, "hex")
+		hdr.digits = max(8, col.digits)
+		hdr.base = l.base
+		return hdr
+	case "hexdump":
+		if col.lower {
+			return NewHexdumpLower(output, l.width, l.sep)
+		}
+		return NewHexdump(output, l.width, l.sep)
+	case "hexbytes":
+		if col.lower {
+			return NewHexbytesLower(output, l.width)
+		}
+		return NewHexbytes(output, l.width)
+	case "octal":
+		return NewOctal(output, l.width, l.sep)
+	case "decimal":
+		return NewDecimal(output, l.width, l.sep)
+	case "binary":
+		return NewBinary(output, l.width, l.sep, col.group)
+	case "number":
+		return NewNumbers(output, l.width, col.word, col.kind, col.big)
+	case "printable":
+		p := NewPrintableSep(output, col.encoding, l.width, col.start_ch, col.end_ch)
+		p.components = col.components
+		p.control_style = col.control
+		return p
+	}
+	return io.Discard
+}
+
+// resync_lookback is how far RenderRows looks back for the escape sequence,
+// shift or line feed that sets the state of a stateful encoding.
+const resync_lookback = 64 * 1024
+
+// stateful tells if the decoding of encoding name depends on earlier bytes.
+func stateful(name string) bool {
+	_, host := ebcdic_lookup(name)
+	return host || iso2022_variant(name) != "" || utf7_name(name)
+}
+
+// resync returns the position in p from where decoding reaches the same
+// state as decoding all of p.
+func resync(name string, p []byte) int {
+	if _, ok := ebcdic_lookup(name); ok {
+		return ebcdic_resync(p)
+	}
+	if utf7_name(name) {
+		return utf7_resync(p)
+	}
+	return iso2022_resync(name, p)
+}
+
+// rewind moves start back to a row from where the stateful encodings of the
+// printable columns decode the rows after start as in a full dump.
+func (l *Layout) rewind(input io.ReaderAt, start uint64) uint64 {
+	stateful_column := func(col column) bool {
+		return col.name == "printable" && stateful(col.encoding)
+	}
+	if !slices.ContainsFunc(l.columns, stateful_column) {
+		return start
+	}
+	from := start - min(start, resync_lookback)
+	buf := make([]byte, start-from)
+	n, err := input.ReadAt(buf, int64(from))
+	if err != nil && err != io.EOF {
+		return start
+	}
+	buf = buf[:n]
+	pos := len(buf)
+	for _, col := range l.columns {
+		if stateful_column(col) {
+			pos = min(pos, resync(col.encoding, buf))
+		}
+	}
+	if pos == len(buf) {
+		return start
+	}
+	res := from + uint64(pos)
+	return res - res%uint64(l.width)
+}
+
+// Row is a rendered row: the text of each column, as in the text output.
+type Row struct {
+	Texts []string
+	// wide has the controls of each column that take more cells than bytes,
+	// with the offsets from the start of the row
+	wide [][]trace_item
+}
+
+// RenderRows renders count rows of input starting at row first. Rendering
+// starts one row earlier so that a character crossing the row boundary is
+// decoded, and the extra row is dropped.
+func (l *Layout) RenderRows(input io.ReaderAt, size uint64, first uint64, count int) ([]Row, error) {
+	width := uint64(l.width)
+	start := first * width
+	if first != 0 {
+		start -= width
+	}
+	start = l.rewind(input, start)
+	skip := int((first*width - start) / width)
+	end := min(size, (first+uint64(count))*width+8)
+	if start >= end {
+		return nil, nil
+	}
+	data := make([]byte, end-start)
+	n, err := input.ReadAt(data, int64(start))
+	if err != nil && err != io.EOF {
+		return nil, err
+	}
+	data = data[:n]
+	var rows []Row
+	for idx, col := range l.columns {
+		buf := &bytes.Buffer{}
+		wr := l.new_writer(col, buf)
+		var t *tracer
+		if p, ok := wr.(*Printable); ok {
+			t = &tracer{width: l.width}
+			p.trace = t
+		}
+		if ofs, ok := wr.(offsetter); ok {
+			ofs.SetOffset(start)
+		}
+		if _, err := wr.Write(data); err != nil {
+			return nil, err
+		}
+		if closer, ok := wr.(io.Closer); ok {
+			if err := closer.Close(); err != nil {
+				return nil, err
+			}
+		}
+		lines := strings.Split(buf.String(), "\n")
+		lines = lines[min(skip, len(lines)):]
+		lines = lines[:min(count, len(lines))]
+		for len(rows) < len(lines) {
+			rows = append(rows, Row{
+				Texts: make([]string, len(l.columns)),
+				wide:  make([][]trace_item, len(l.columns)),
+			})
+		}
+		for i, line := range lines {
+			rows[i].Texts[idx] = line
+		}
+		for _, item := range t.wide(first * width) {
+			if i := int((item.Offset - first*width) / width); i < len(lines) {
+				item.Offset %= width
+				rows[i].wide[idx] = append(rows[i].wide[idx], item)
+			}
+		}
+	}
+	return rows, nil
+}
+
+const (
+	highlight_on  = "\x1b[7m"
+	highlight_off = "\x1b[27m"
+)
+
+// highlight_cells marks the runes of txt that cover any of the given cells.
+// Color codes in txt are kept and do not take a cell.
+func highlight_cells(txt string, cell int, cells []int) string {
+	var sb strings.Builder
+	inside := false
+	for len(txt) > 0 {
+		if loc := ansiEscape.FindStringIndex(txt); loc != nil && loc[0] == 0 {
+			if inside {
+				sb.WriteString(highlight_off)
+				inside = false
+			}
+			sb.WriteString(txt[:loc[1]])
+			txt = txt[loc[1]:]
+			continue
+		}
+		r, size := utf8.DecodeRuneInString(txt)
+		w := runeWidth(r)
+		marked := slices.ContainsFunc(cells, func(c int) bool { return cell <= c && c < cell+w })
+		if marked && !inside {
+			sb.WriteString(highlight_on)
+		} else if !marked && inside {
+			sb.WriteString(highlight_off)
+		}
+		inside = marked
+		sb.WriteString(txt[:size])
+		cell += w
+		txt = txt[size:]
+	}
+	if inside {
+		sb.WriteString(highlight_off)
+	}
+	return sb.String()
+}
+
+// byte_cells returns the first cell and the number of cells of the byte at
+// index idx of a row rendered by col, or 0 cells for a column without bytes.
+// wide are the controls of the row that take more cells than bytes and move
+// the rest of a printable column.
+func (l *Layout) byte_cells(col column, idx int, wide []trace_item) (int, int) {
+	switch col.name {
+	case "hexdump":
+		return 3*idx + idx/l.sep + 1, 2
+	case "hexbytes":
+		return 6 * idx, 4
+	case "octal", "decimal", "binary":
+		digits := byte_digits(col)
+		return (digits+1)*idx + idx/l.sep + 1, digits
+	case "printable":
+		shift := 0
+		for _, item := range wide {
+			pos := int(item.Offset)
+			if idx < pos {
+				break
+			}
+			if idx < pos+item.Size {
+				return pos + shift, textWidth(item.Text)
+			}
+			shift += textWidth(item.Text) - item.Size
+		}
+		return idx + shift, 1
+	}
+	return 0, 0
+}
+
+// highlight marks the bytes at the given indexes of a row rendered by col.
+func (l *Layout) highlight(col column, txt string, wide []trace_item, idxs ...int) string {
+	if len(idxs) == 0 {
+		return txt
+	}
+	cells := make([]int, 0, 3*len(idxs))
+	for _, idx := range idxs {
+		start, n := l.byte_cells(col, idx, wide)
+		for cell := start; cell < start+n; cell++ {
+			cells = append(cells, cell)
+		}
+	}
+	cell := 0
+	if col.start_ch != "" && strings.HasPrefix(txt, col.start_ch) {
+		cell = -textWidth(col.start_ch)
+	}
+	return highlight_cells(txt, cell, cells)
+}
+
+// MarkRow returns a line with "^" under the cells of the bytes at idxs of a
+// row joined by ComposeRow, to show them without color.
+func (l *Layout) MarkRow(row Row, idxs ...int) string {
+	var sb strings.Builder
+	written, start := 0, 0
+	for idx, col := range l.columns {
+		var txt string
+		var wide []trace_item
+		if idx < len(row.Texts) {
+			txt = row.Texts[idx]
+		}
+		if idx < len(row.wide) {
+			wide = row.wide[idx]
+		}
+		cells := []int{}
+		for _, i := range idxs {
+			first, n := l.byte_cells(col, i, wide)
+			for c := range n {
+				cells = append(cells, start+first+c)
+			}
+		}
+		slices.Sort(cells)
+		for _, c := range slices.Compact(cells) {
+			sb.WriteString(strings.Repeat(" ", c-written))
+			sb.WriteString("^")
+			written = c + 1
+		}
+		start += max(col.width, textWidth(txt))
+	}
+	return sb.String()
+}
+
+// ComposeRow joins the texts of one row, padding each column to its width
+// and highlighting the bytes at idxs.
+func (l *Layout) ComposeRow(row Row, idxs ...int) string {
+	var sb strings.Builder
+	for idx, col := range l.columns {
+		txt := ""
+		if idx < len(row.Texts) {
+			var wide []trace_item
+			if idx < len(row.wide) {
+				wide = row.wide[idx]
+			}
+			txt = l.highlight(col, row.Texts[idx], wide, idxs...)
+		}
+		sb.WriteString(txt)
+		if pad := col.width - textWidth(txt); pad > 0 && idx != len(l.columns)-1 {
+			sb.WriteString(strings.Repeat(" ", pad))
+		}
+	}
+	return sb.String()
+}
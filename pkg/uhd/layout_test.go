@@ -0,0 +1,315 @@
+package uhd
+
+import (
+	"bytes"
+	"reflect"
+	"testing"
+
+	"github.com/fatih/color"
+)
+
+func TestGetLayout_Predefined(t *testing.T) {
+	layout, err := get_layout("hexdump", "utf-8", 16, 8)
+	if err != nil {
+		t.Fatal("get_layout", "err", err)
+	}
+	expected := []column{
+		{name: "header", width: 9},
+		{name: "hexdump", width: 53, lower: true},
+		{name: "printable", width: 18, encoding: "utf-8", start_ch: "|", end_ch: "|"},
+	}
+	if !reflect.DeepEqual(layout, expected) {
+		t.Errorf("unexpected layout:\ngot:  %+v\nwant: %+v", layout, expected)
+	}
+}
+
+func TestGetLayout_Columns(t *testing.T) {
+	layout, err := get_layout("header_lower,hexbytes:upper,printable:shift-jis,printable:encoding=utf-16le:start=[:end=]", "utf-8", 16, 8)
+	if err != nil {
+		t.Fatal("get_layout", "err", err)
+	}
+	expected := []column{
+		{name: "header", width: 9, lower: true},
+		{name: "hexbytes", width: 97},
+		{name: "printable", width: 17, encoding: "shift-jis"},
+		{name: "printable", width: 18, encoding: "utf-16le", start_ch: "[", end_ch: "]"},
+	}
+	if !reflect.DeepEqual(layout, expected) {
+		t.Errorf("unexpected layout:\ngot:  %+v\nwant: %+v", layout, expected)
+	}
+}
+
+func TestGetLayout_Invalid(t *testing.T) {
+	for _, spec := range []string{"unknown", "header,hexdump:shift-jis", "printable:case=title", "header:foo=bar", "header:components", "printable:graphemes=words", "header:control=caret", "printable:control=octal", "hexdump:be", "u16le:endian=middle", "f16le", "i24le", "binary:group=0", "octal:group=2", "hexdump:dec", "header:format=bin"} {
+		if _, err := get_layout(spec, "utf-8", 16, 8); err == nil {
+			t.Error("no error", "spec", spec)
+		}
+	}
+}
+
+func TestGetLayout_MultiEncoding(t *testing.T) {
+	layout, err := get_layout("header,printable,printable_pipe:big5", "utf-8,shift-jis,euc-jp", 16, 8)
+	if err != nil {
+		t.Fatal("get_layout", "err", err)
+	}
+	expected := []column{
+		{name: "header", width: 9},
+		{name: "printable", width: 17, encoding: "utf-8"},
+		{name: "printable", width: 17, encoding: "shift-jis"},
+		{name: "printable", width: 17, encoding: "euc-jp"},
+		{name: "printable", width: 18, encoding: "big5", start_ch: "|", end_ch: "|"},
+	}
+	if !reflect.DeepEqual(layout, expected) {
+		t.Errorf("unexpected layout:\ngot:  %+v\nwant: %+v", layout, expected)
+	}
+	if _, err := get_layout("jhd", "utf-8,no-such-encoding", 16, 8); err == nil {
+		t.Error("no error for unknown encoding")
+	}
+	if _, err := ParseLayout("jhd", "utf-8", 16, 0); err == nil {
+		t.Error("no error for sep 0")
+	}
+}
+
+//nolint:gosmopolitan
+func TestGetLayout_Numbers(t *testing.T) {
+	layout, err := get_layout("header,u16be,int,float:be,i8:le", "utf-8", 16, 4)
+	if err != nil {
+		t.Fatal("get_layout", "err", err)
+	}
+	// int and float take the word size from sep
+	expected := []column{
+		{name: "header", width: 9},
+		{name: "number", width: 8*6 + 1, kind: NumberUint, word: 2, big: true},
+		{name: "number", width: 4*12 + 1, kind: NumberInt, word: 4},
+		{name: "number", width: 4*15 + 1, kind: NumberFloat, word: 4, big: true},
+		{name: "number", width: 16*5 + 1, kind: NumberInt, word: 1},
+	}
+	if !reflect.DeepEqual(layout, expected) {
+		t.Errorf("unexpected layout:\ngot:  %+v\nwant: %+v", layout, expected)
+	}
+	for _, spec := range []string{"float", "u64le"} {
+		if _, err := get_layout(spec, "utf-8", 12, 2); err == nil {
+			t.Error("no error", "spec", spec)
+		}
+	}
+}
+
+func TestGetLayout_Bytedump(t *testing.T) {
+	layout, err := get_layout("octal,decimal,binary:group=4", "utf-8", 16, 8)
+	if err != nil {
+		t.Fatal("get_layout", "err", err)
+	}
+	expected := []column{
+		{name: "octal", width: 67},
+		{name: "decimal", width: 67},
+		{name: "binary", width: 163, group: 4},
+	}
+	if !reflect.DeepEqual(layout, expected) {
+		t.Errorf("unexpected layout:\ngot:  %+v\nwant: %+v", layout, expected)
+	}
+}
+
+func TestLayout_SetControlStyle(t *testing.T) {
+	layout, err := ParseLayout("header,printable,printable:control=caret,printable_pipe", "utf-8", 16, 8)
+	if err != nil {
+		t.Fatal("ParseLayout", "err", err)
+	}
+	if err := layout.SetControlStyle("names"); err != nil {
+		t.Fatal("SetControlStyle", "err", err)
+	}
+	// a column with its own style keeps it, and the gap stays
+	expected := []column{
+		{name: "header", width: 9},
+		{name: "printable", width: 49, encoding: "utf-8", control: "names"},
+		{name: "printable", width: 33, encoding: "utf-8", control: "caret"},
+		{name: "printable", width: 50, encoding: "utf-8", start_ch: "|", end_ch: "|", control: "names"},
+	}
+	if !reflect.DeepEqual(layout.columns, expected) {
+		t.Errorf("unexpected layout:\ngot:  %+v\nwant: %+v", layout.columns, expected)
+	}
+	if err := layout.SetControlStyle("braille"); err == nil {
+		t.Error("no error for an invalid style")
+	}
+}
+
+func TestLayout_Address(t *testing.T) {
+	layout, err := ParseLayout("header,header:oct,hexdump", "utf-8", 16, 8)
+	if err != nil {
+		t.Fatal("ParseLayout", "err", err)
+	}
+	if err := layout.SetAddressFormat("dec"); err != nil {
+		t.Fatal("SetAddressFormat", "err", err)
+	}
+	layout.AddOtherRadix()
+	layout.SetBaseAddress(0x08000000)
+	layout.SetAddressLimit(0x1_0000_0000)
+	// the last address is 0x107FFFFFF: 4429185023 in decimal, 41037777777 in
+	// octal
+	expected := []column{
+		{name: "header", width: 11, address: "dec", digits: 10},
+		{name: "header", width: 10, address: "hex", digits: 9},
+		{name: "header", width: 12, address: "oct", digits: 11},
+		{name: "header", width: 10, address: "hex", digits: 9},
+		{name: "hexdump", width: 53},
+	}
+	if !reflect.DeepEqual(layout.columns, expected) {
+		t.Errorf("unexpected layout:\ngot:  %+v\nwant: %+v", layout.columns, expected)
+	}
+	if err := layout.SetAddressFormat("bin"); err == nil {
+		t.Error("no error for an invalid format")
+	}
+}
+
+func row_texts(rows []Row) [][]string {
+	res := make([][]string, 0, len(rows))
+	for _, row := range rows {
+		res = append(res, row.Texts)
+	}
+	return res
+}
+
+func TestRenderRows(t *testing.T) {
+	oldNoColor := color.NoColor
+	color.NoColor = true
+	defer func() { color.NoColor = oldNoColor }()
+	data := []byte("0123456こんにちは")
+	layout, err := ParseLayout("header,printable", "utf-8", 8, 8)
+	if err != nil {
+		t.Fatal("ParseLayout", "err", err)
+	}
+	rows, err := layout.RenderRows(bytes.NewReader(data), uint64(len(data)), 1, 2)
+	if err != nil {
+		t.Fatal("RenderRows", "err", err)
+	}
+	expected := [][]string{
+		{"00000008", "__ん_に_"},
+		{"00000010", "ち_は_"},
+	}
+	if got := row_texts(rows); !reflect.DeepEqual(got, expected) {
+		t.Errorf("unexpected rows:\ngot:  %q\nwant: %q", got, expected)
+	}
+}
+
+//nolint:gosmopolitan
+func TestRenderRows_ISO2022JP(t *testing.T) {
+	oldNoColor := color.NoColor
+	color.NoColor = true
+	defer func() { color.NoColor = oldNoColor }()
+	// the escape sequence is two rows before the rendered ones
+	data := []byte("\x1b$B$3$s$K$A$O@$3&$3$s$K$A$O\x1b(Bok")
+	layout, err := ParseLayout("printable", "iso-2022-jp", 8, 8)
+	if err != nil {
+		t.Fatal("ParseLayout", "err", err)
+	}
+	rows, err := layout.RenderRows(bytes.NewReader(data), uint64(len(data)), 2, 2)
+	if err != nil {
+		t.Fatal("RenderRows", "err", err)
+	}
+	expected := [][]string{{"_こんにち"}, {"_は^(Bok"}}
+	if got := row_texts(rows); !reflect.DeepEqual(got, expected) {
+		t.Errorf("unexpected rows:\ngot:  %q\nwant: %q", got, expected)
+	}
+}
+
+//nolint:gosmopolitan
+func TestRenderRows_EBCDIC(t *testing.T) {
+	oldNoColor := color.NoColor
+	color.NoColor = true
+	defer func() { color.NoColor = oldNoColor }()
+	// SO is two rows before the rendered ones
+	data := []byte("\x0e\x4f\x58\x48\xf2\x44\x86\x45\x5c\x4f\x58\x48\xf2\x44\x86\x45\x5c\x4f\x58\x48\xf2\x0f\xc1\x82")
+	layout, err := ParseLayout("printable", "ibm939", 8, 8)
+	if err != nil {
+		t.Fatal("ParseLayout", "err", err)
+	}
+	rows, err := layout.RenderRows(bytes.NewReader(data), uint64(len(data)), 2, 1)
+	if err != nil {
+		t.Fatal("RenderRows", "err", err)
+	}
+	expected := [][]string{{"_漢字}Ab"}}
+	if got := row_texts(rows); !reflect.DeepEqual(got, expected) {
+		t.Errorf("unexpected rows:\ngot:  %q\nwant: %q", got, expected)
+	}
+}
+
+//nolint:gosmopolitan
+func TestRenderRows_UTF7(t *testing.T) {
+	oldNoColor := color.NoColor
+	color.NoColor = true
+	defer func() { color.NoColor = oldNoColor }()
+	// the base64 section starts two rows before the rendered one
+	data := []byte("ab +ZeVnLIqeZeVnLIqeZeVnLIqe-ok")
+	layout, err := ParseLayout("printable", "utf-7", 8, 8)
+	if err != nil {
+		t.Fatal("ParseLayout", "err", err)
+	}
+	rows, err := layout.RenderRows(bytes.NewReader(data), uint64(len(data)), 2, 1)
+	if err != nil {
+		t.Fatal("RenderRows", "err", err)
+	}
+	expected := [][]string{{"__語日_本"}}
+	if got := row_texts(rows); !reflect.DeepEqual(got, expected) {
+		t.Errorf("unexpected rows:\ngot:  %q\nwant: %q", got, expected)
+	}
+}
+
+func TestComposeRow_control(t *testing.T) {
+	oldNoColor := color.NoColor
+	color.NoColor = true
+	defer func() { color.NoColor = oldNoColor }()
+	data := []byte("d\ttail\x01\x02tail")
+	tests := []struct {
+		control  string
+		idxs     []int
+		expected string
+	}{
+		{"dots", []int{2, 3, 4, 5, 8, 9, 10, 11}, "d.\x1b[7mtail\x1b[27m..\x1b[7mtail\x1b[27m"},
+		{"caret", []int{2, 3, 4, 5, 8, 9, 10, 11}, "d^I\x1b[7mtail\x1b[27m^A^B\x1b[7mtail\x1b[27m"},
+		{"names", []int{2, 3, 4, 5, 8, 9, 10, 11}, "dHT\x1b[7mtail\x1b[27mSOHSTX\x1b[7mtail\x1b[27m"},
+		{"caret", []int{1, 7}, "d\x1b[7m^I\x1b[27mtail^A\x1b[7m^B\x1b[27mtail"},
+		{"names", []int{7}, "dHTtailSOH\x1b[7mSTX\x1b[27mtail"},
+	}
+	for _, tt := range tests {
+		layout, err := ParseLayout("printable:control="+tt.control, "utf-8", 16, 8)
+		if err != nil {
+			t.Fatal("ParseLayout", "err", err)
+		}
+		rows, err := layout.RenderRows(bytes.NewReader(data), uint64(len(data)), 0, 1)
+		if err != nil {
+			t.Fatal("RenderRows", "err", err)
+		}
+		if len(rows) != 1 {
+			t.Fatalf("unexpected rows: %d", len(rows))
+		}
+		if got := layout.ComposeRow(rows[0], tt.idxs...); got != tt.expected {
+			t.Errorf("unexpected output (%s):\ngot:  %q\nwant: %q", tt.control, got, tt.expected)
+		}
+	}
+}
+
+//nolint:gosmopolitan
+func TestMarkRow(t *testing.T) {
+	oldNoColor := color.NoColor
+	color.NoColor = true
+	defer func() { color.NoColor = oldNoColor }()
+	data := []byte("d\tあtail")
+	layout, err := ParseLayout("header,hexdump,printable:control=caret", "utf-8", 8, 4)
+	if err != nil {
+		t.Fatal("ParseLayout", "err", err)
+	}
+	rows, err := layout.RenderRows(bytes.NewReader(data), uint64(len(data)), 0, 1)
+	if err != nil {
+		t.Fatal("RenderRows", "err", err)
+	}
+	if len(rows) != 1 {
+		t.Fatalf("unexpected rows: %d", len(rows))
+	}
+	expected := []string{
+		"00000000  64 09 E3 81  82 74 61 69   d^Iあ_tai",
+		"                          ^^ ^^ ^^         ^^^",
+	}
+	got := []string{layout.ComposeRow(rows[0]), layout.MarkRow(rows[0], 5, 6, 7)}
+	if !reflect.DeepEqual(got, expected) {
+		t.Errorf("unexpected output:\ngot:  %q\nwant: %q", got, expected)
+	}
+}
@@ -0,0 +1,145 @@
+package uhd
+
+import (
+	"fmt"
+	"io"
+	"log/slog"
+	"math"
+	"strconv"
+)
+
+// The kinds of values of a Numbers: unsigned and signed integers and IEEE 754
+// floats.
+const (
+	NumberUint  byte = 'u'
+	NumberInt   byte = 'i'
+	NumberFloat byte = 'f'
+)
+
+// valid_word tells if a number column of kind can read words of size bytes.
+func valid_word(kind byte, size int) bool {
+	switch size {
+	case 1, 2:
+		return kind != NumberFloat
+	case 4, 8:
+		return true
+	}
+	return false
+}
+
+// number_width returns the cells of the longest value of kind in size bytes.
+func number_width(kind byte, size int) int {
+	switch kind {
+	case NumberFloat:
+		if size == 4 {
+			return len("-1.1754944e-38")
+		}
+		return len("-2.2250738585072014e-308")
+	case NumberInt:
+		return len(strconv.FormatInt(math.MinInt64>>(64-8*size), 10))
+	}
+	return len(strconv.FormatUint(math.MaxUint64>>(64-8*size), 10))
+}
+
+// Numbers reads the bytes as words of size bytes and writes their values,
+// right-aligned, width bytes per row. Words that are cut by the start or the
+// end of the dump are left blank.
+type Numbers struct {
+	output io.Writer
+	cur    uint64
+	width  int
+	size   int
+	kind   byte
+	big    bool
+	word   []byte
+	skip   int
+	cells  int
+}
+
+func (h *Numbers) value(b []byte) string {
+	var v uint64
+	for idx := range b {
+		if h.big {
+			v = v<<8 | uint64(b[idx])
+		} else {
+			v = v<<8 | uint64(b[len(b)-1-idx])
+		}
+	}
+	switch h.kind {
+	case NumberFloat:
+		if h.size == 4 {
+			return strconv.FormatFloat(float64(math.Float32frombits(uint32(v))), 'g', -1, 32)
+		}
+		return strconv.FormatFloat(math.Float64frombits(v), 'g', -1, 64)
+	case NumberInt:
+		shift := 64 - 8*h.size
+		return strconv.FormatInt(int64(v<<shift)>>shift, 10)
+	}
+	return strconv.FormatUint(v, 10)
+}
+
+func (h *Numbers) field(s string) {
+	fmt.Fprintf(h.output, " %*s", h.cells, s)
+}
+
+func (h *Numbers) Write(p []byte) (n int, err error) {
+	for _, ch := range p {
+		h.cur++
+		if h.skip > 0 {
+			h.skip--
+		} else {
+			h.word = append(h.word, ch)
+			if len(h.word) == h.size {
+				h.field(h.value(h.word))
+				h.word = h.word[:0]
+			}
+		}
+		if h.cur%uint64(h.width) == 0 {
+			fmt.Fprint(h.output, "\n")
+		}
+	}
+	return len(p), nil
+}
+
+// SetOffset starts the dump at offset, leaving blanks for the words before it
+// in the first row and for the word it cuts.
+func (h *Numbers) SetOffset(offset uint64) {
+	h.cur = offset
+	pos := int(offset % uint64(h.width))
+	for range (pos + h.size - 1) / h.size {
+		h.field("")
+	}
+	if pos%h.size != 0 {
+		h.skip = h.size - pos%h.size
+	}
+}
+
+func (h *Numbers) Close() (err error) {
+	if len(h.word) != 0 {
+		h.field("")
+	}
+	if h.cur%uint64(h.width) != 0 {
+		fmt.Fprint(h.output, "\n")
+	}
+	if closer, ok := h.output.(io.Closer); ok {
+		if err := closer.Close(); err != nil {
+			slog.Error("close writer", "err", err)
+			return err
+		}
+	}
+	return nil
+}
+
+// NewNumbers creates a Numbers of kind NumberUint, NumberInt or NumberFloat
+// for words of size bytes, big endian if big is set.
+func NewNumbers(output io.Writer, width int, size int, kind byte, big bool) *Numbers {
+	return &Numbers{
+		output: output,
+		cur:    0,
+		width:  width,
+		size:   size,
+		kind:   kind,
+		big:    big,
+		cells:  number_width(kind, size),
+	}
+}
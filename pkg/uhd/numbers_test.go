@@ -0,0 +1,75 @@
+package uhd
+
+import (
+	"bytes"
+	"testing"
+)
+
+func TestNumbers(t *testing.T) {
+	input := []byte{0xfe, 0xff, 0xff, 0xff, 0x00, 0x00, 0xc0, 0x3f, 0x01}
+	tests := []struct {
+		kind     byte
+		size     int
+		big      bool
+		expected string
+	}{
+		{NumberUint, 1, false, " 254 255 255 255\n   0   0 192  63\n   1\n"},
+		{NumberInt, 1, false, "   -2   -1   -1   -1\n    0    0  -64   63\n    1\n"},
+		{NumberUint, 2, false, " 65534 65535\n     0 16320\n      \n"},
+		{NumberInt, 2, true, "   -257     -1\n      0 -16321\n       \n"},
+		{NumberInt, 4, false, "          -2\n  1069547520\n            \n"},
+		{NumberUint, 4, true, " 4278190079\n      49215\n           \n"},
+		{NumberFloat, 4, false, "            NaN\n            1.5\n               \n"},
+	}
+	for _, tt := range tests {
+		buf := &bytes.Buffer{}
+		h := NewNumbers(buf, 4, tt.size, tt.kind, tt.big)
+		if _, err := h.Write(input); err != nil {
+			t.Fatal("write", "err", err)
+		}
+		if err := h.Close(); err != nil {
+			t.Fatal("close", "err", err)
+		}
+		if buf.String() != tt.expected {
+			t.Errorf("unexpected output of %c%d:\ngot:  %q\nwant: %q", tt.kind, 8*tt.size, buf.String(), tt.expected)
+		}
+	}
+}
+
+func TestNumbers_64bit(t *testing.T) {
+	input := []byte{0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xc0, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x80}
+	for kind, expected := range map[byte]string{
+		NumberUint:  " 13835621005235585024  9223372036854775808\n",
+		NumberInt:   " -4611123068473966592 -9223372036854775808\n",
+		NumberFloat: "                    -2.25                       -0\n",
+	} {
+		buf := &bytes.Buffer{}
+		h := NewNumbers(buf, 16, 8, kind, false)
+		if _, err := h.Write(input); err != nil {
+			t.Fatal("write", "err", err)
+		}
+		if err := h.Close(); err != nil {
+			t.Fatal("close", "err", err)
+		}
+		if buf.String() != expected {
+			t.Errorf("unexpected output of %c64:\ngot:  %q\nwant: %q", kind, buf.String(), expected)
+		}
+	}
+}
+
+func TestNumbers_SetOffset(t *testing.T) {
+	buf := &bytes.Buffer{}
+	h := NewNumbers(buf, 8, 2, NumberUint, true)
+	// the word cut by the offset is blank
+	h.SetOffset(0x13)
+	if _, err := h.Write([]byte{0x01, 0x02, 0x03, 0x04, 0x05, 0x06}); err != nil {
+		t.Fatal("write", "err", err)
+	}
+	if err := h.Close(); err != nil {
+		t.Fatal("close", "err", err)
+	}
+	expected := "               515  1029\n      \n"
+	if buf.String() != expected {
+		t.Errorf("unexpected output:\ngot:  %q\nwant: %q", buf.String(), expected)
+	}
+}
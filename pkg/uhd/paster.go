@@ -0,0 +1,195 @@
+package uhd
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"log/slog"
+	"strconv"
+	"strings"
+)
+
+// textWidth returns the number of terminal cells of s, ignoring color codes.
+func textWidth(s string) int {
+	res := 0
+	for _, r := range ansiEscape.ReplaceAllString(s, "") {
+		res += runeWidth(r)
+	}
+	return res
+}
+
+func (p *paster) column(width int, txt string) {
+	fmt.Fprint(p.writer, txt)
+	if pad := width - textWidth(txt); pad > 0 {
+		fmt.Fprint(p.writer, strings.Repeat(" ", pad))
+	}
+}
+
+// paster joins the lines of the column readers into rows. Rows whose line of
+// keys repeats the previous one are squeezed into "*", or into
+// "* (N rows, 0xNNNN bytes of XX)" if counts is set.
+type paster struct {
+	writer  io.Writer
+	keys    *bufio.Scanner
+	counts  bool
+	readers []*bufio.Scanner
+}
+
+// squeeze_count returns the line of rows squeezed away, size bytes in total,
+// as "* (N rows, 0xNNNN bytes of XX)". fill is the byte all of them are filled
+// with, or -1.
+func squeeze_count(rows int, size uint64, fill int) string {
+	unit := "rows"
+	if rows == 1 {
+		unit = "row"
+	}
+	if fill >= 0 {
+		return fmt.Sprintf("* (%d %s, 0x%X bytes of %02X)", rows, unit, size, fill)
+	}
+	return fmt.Sprintf("* (%d %s, 0x%X bytes)", rows, unit, size)
+}
+
+// squeezed returns the line of n rows squeezed away, whose bytes are key in
+// hex.
+func (p *paster) squeezed(n int, key string) string {
+	if !p.counts {
+		return "*"
+	}
+	fill := -1
+	if len(key) >= 2 && key == strings.Repeat(key[:2], len(key)/2) {
+		if val, err := strconv.ParseUint(key[:2], 16, 8); err == nil {
+			fill = int(val)
+		}
+	}
+	return squeeze_count(n, uint64(n*len(key)/2), fill)
+}
+
+func (p *paster) Process(widths ...int) error {
+	var prev []string
+	var prevkey string
+	var dups = 0
+	txts := make([]string, 0, len(p.readers))
+	for {
+		eof := true
+		for _, reader := range p.readers {
+			got := reader.Scan()
+			if !got {
+				continue
+			}
+			eof = false
+			txt := reader.Text()
+			txts = append(txts, txt)
+			if reader.Err() != nil {
+				slog.Error("scan error", "err", reader.Err())
+				return reader.Err()
+			}
+		}
+		var key string
+		if p.keys != nil && p.keys.Scan() {
+			key = p.keys.Text()
+		} else if p.keys != nil && p.keys.Err() != nil {
+			slog.Error("scan error", "err", p.keys.Err())
+			return p.keys.Err()
+		}
+		if len(txts) == 0 {
+			if dups != 0 {
+				// show the last row so that the length of the data is visible
+				if dups > 1 {
+					fmt.Fprintln(p.writer, p.squeezed(dups-1, prevkey))
+				}
+				for idx, txt := range prev {
+					p.column(widths[idx], txt)
+				}
+				fmt.Fprint(p.writer, "\n")
+			}
+			break
+		}
+		if len(prev) != 0 && p.keys != nil && key == prevkey {
+			dups++
+		} else {
+			if dups != 0 {
+				fmt.Fprintln(p.writer, p.squeezed(dups, prevkey))
+			}
+			dups = 0
+			for idx, txt := range txts {
+				p.column(widths[idx], txt)
+			}
+			if eof {
+				slog.Debug("eof")
+				break
+			}
+			fmt.Fprint(p.writer, "\n")
+		}
+		prev = txts[:]
+		prevkey = key
+		txts = make([]string, 0, len(p.readers))
+		slog.Debug("line", "prev", prev, "txts", txts)
+	}
+	return nil
+}
+
+// newPaster creates a paster of the readers. The lines of keys tell the
+// repeated rows, and no row is squeezed if keys is nil.
+func newPaster(writer io.Writer, keys io.Reader, counts bool, readers ...io.Reader) *paster {
+	rds := make([]*bufio.Scanner, 0)
+	for _, rd := range readers {
+		rds = append(rds, bufio.NewScanner(rd))
+	}
+	p := &paster{
+		writer:  writer,
+		counts:  counts,
+		readers: rds,
+	}
+	if keys != nil {
+		p.keys = bufio.NewScanner(keys)
+	}
+	return p
+}
+
+// rowkeys writes the bytes of each row as a line of hex, the keys that tell a
+// paster which rows repeat. The bytes before the offset are "--".
+type rowkeys struct {
+	output io.Writer
+	cur    uint64
+	width  int
+	line   []byte
+}
+
+func (k *rowkeys) Write(p []byte) (n int, err error) {
+	for _, ch := range p {
+		k.line = fmt.Appendf(k.line, "%02X", ch)
+		k.cur++
+		if k.cur%uint64(k.width) == 0 {
+			if err := k.flush(); err != nil {
+				return 0, err
+			}
+		}
+	}
+	return len(p), nil
+}
+
+func (k *rowkeys) flush() error {
+	_, err := k.output.Write(append(k.line, '\n'))
+	k.line = k.line[:0]
+	return err
+}
+
+func (k *rowkeys) SetOffset(offset uint64) {
+	k.cur = offset
+	k.line = append(k.line, strings.Repeat("--", int(offset%uint64(k.width)))...)
+}
+
+func (k *rowkeys) Close() error {
+	if len(k.line) != 0 {
+		if err := k.flush(); err != nil {
+			return err
+		}
+	}
+	if closer, ok := k.output.(io.Closer); ok {
+		if err := closer.Close(); err != nil {
+			slog.Error("close writer", "err", err)
+			return err
+		}
+	}
+	return nil
+}
@@ -0,0 +1,1366 @@
+package uhd
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"log/slog"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+
+	"github.com/fatih/color"
+	"golang.org/x/text/encoding"
+	"golang.org/x/text/encoding/charmap"
+	"golang.org/x/text/encoding/japanese"
+	"golang.org/x/text/encoding/korean"
+	"golang.org/x/text/encoding/simplifiedchinese"
+	"golang.org/x/text/encoding/traditionalchinese"
+	xunicode "golang.org/x/text/encoding/unicode"
+	"golang.org/x/text/encoding/unicode/utf32"
+	"golang.org/x/text/width"
+)
+
+type Printable struct {
+	output   io.Writer
+	cur      uint64
+	width    int
+	encoding string
+	rest     []byte
+	start_ch string
+	end_ch   string
+	lendian  bool
+	trace    *tracer
+	iso      *iso2022
+	host     *ebcdic
+	utf7     *utf7
+	// components shows the code points of a grapheme cluster one by one
+	components bool
+	// split shows the rest of a cluster that was too long one code point at
+	// a time
+	split bool
+	// control_style is how the C0 controls and DEL are shown, "" for dots
+	control_style string
+}
+
+type uintrange struct {
+	start uint
+	end   uint
+}
+
+func sjis_single(ch byte) bool {
+	return ch <= 0x7f || (0xa1 <= ch && ch <= 0xdf)
+}
+
+func valid_sjis(b1, b2 byte) bool {
+	// info from http://charset.7jp.net/sjis.html
+	invalid := []uintrange{
+		{0x81ad, 0x81b7},
+		{0x81c0, 0x81c7},
+		{0x81cf, 0x81d9},
+		{0x81e9, 0x81ef},
+		{0x81f8, 0x81fb},
+
+		{0x8240, 0x824e},
+		{0x8259, 0x825f},
+		{0x827a, 0x8280},
+		{0x829b, 0x829e},
+		{0x82f2, 0x82fc},
+
+		{0x8397, 0x839e},
+		{0x83b7, 0x83be},
+		{0x83d7, 0x83fc},
+
+		{0x8461, 0x846f},
+		{0x8492, 0x849e},
+		{0x84bf, 0x84fc},
+
+		{0x8540, 0x889e},
+
+		{0x9873, 0x989e},
+
+		{0xa040, 0xdffc},
+		{0xeaa5, 0xeffc},
+		// user-defined area and IBM extensions of CP932
+		{0xf040, 0xfcfc},
+	}
+	ch := (uint(b1) << 8) | uint(b2)
+	for _, r := range invalid {
+		if r.start <= ch && ch <= r.end {
+			slog.Debug("invalid sjis", "b1", b1, "b2", b2, "ch", ch, "range", r)
+			return false
+		}
+	}
+	return true
+}
+
+// trace_item is a character as shown in the printable column. Kind is
+// "char", "unprintable" (invalid or control bytes shown as "."), "bom",
+// "escape" (ISO-2022 escape sequences and shifts), "variant" (characters in a
+// form that standard UTF-8 does not allow), "control" (controls shown in a
+// control style other than dots) or "padding" (bytes of a character that
+// started before the traced range).
+// Padded is set when some of its bytes are shown as "_".
+type trace_item struct {
+	Text   string `json:"text"`
+	Offset uint64 `json:"offset"`
+	Size   int    `json:"size"`
+	Kind   string `json:"kind"`
+	Padded bool   `json:"padded"`
+}
+
+// tracer records what a printable writes and maps it back to bytes. Cell i of
+// a row shows byte row_start+i, and "_" padding adds bytes to the last item.
+// The methods do nothing on a nil tracer.
+type tracer struct {
+	width     int
+	row_start uint64
+	cell      int
+	items     []trace_item
+}
+
+func (t *tracer) add(kind, text string, cells int) {
+	if t == nil {
+		return
+	}
+	size := max(0, min(cells, t.width-t.cell))
+	t.items = append(t.items, trace_item{text, t.row_start + uint64(t.cell), size, kind, false})
+	t.cell += cells
+}
+
+func (t *tracer) pad(n int) {
+	if t == nil {
+		return
+	}
+	if len(t.items) == 0 {
+		t.add("padding", strings.Repeat("_", n), n)
+		t.items[0].Padded = true
+		return
+	}
+	t.items[len(t.items)-1].Size += max(0, min(n, t.width-t.cell))
+	t.items[len(t.items)-1].Padded = true
+	t.cell += n
+}
+
+// wide returns the controls from offset on that take more cells than bytes.
+func (t *tracer) wide(offset uint64) []trace_item {
+	if t == nil {
+		return nil
+	}
+	var res []trace_item
+	for _, item := range t.items {
+		if item.Kind == "control" && item.Offset >= offset && textWidth(item.Text) > item.Size {
+			res = append(res, item)
+		}
+	}
+	return res
+}
+
+// span sets the size of the last item to the bytes of its character, which
+// may go on past the end of the row.
+func (t *tracer) span(size int) {
+	if t == nil || len(t.items) == 0 {
+		return
+	}
+	t.items[len(t.items)-1].Size = size
+}
+
+func (t *tracer) text(s string) {
+	if t == nil {
+		return
+	}
+	for range strings.Count(s, "\n") {
+		t.row_start += uint64(t.width)
+		t.cell = 0
+	}
+}
+
+func (h *Printable) text(s string) {
+	h.trace.text(s)
+	fmt.Fprint(h.output, s)
+}
+
+func (h *Printable) char(s string) {
+	r, _ := utf8.DecodeRuneInString(s)
+	h.glyph(s, h.runeWidth(r))
+}
+
+// glyph shows s that takes cells cells, such as a grapheme cluster.
+func (h *Printable) glyph(s string, cells int) {
+	h.trace.add("char", s, cells)
+	fmt.Fprint(h.output, s)
+}
+
+// variant shows a character in a form that standard UTF-8 does not allow.
+func (h *Printable) variant(s string) {
+	r, _ := utf8.DecodeRuneInString(s)
+	h.trace.add("variant", s, h.runeWidth(r))
+	fmt.Fprint(h.output, color.YellowString(s))
+}
+
+func (h *Printable) pad1(n int) {
+	if n > 0 {
+		h.trace.add("unprintable", strings.Repeat(".", n), n)
+		fmt.Fprint(h.output, color.BlueString(strings.Repeat(".", n)))
+	}
+}
+
+func (h *Printable) pad2(n int) {
+	if n > 0 {
+		h.trace.pad(n)
+		fmt.Fprint(h.output, color.CyanString(strings.Repeat("_", n)))
+	}
+}
+
+// put shows a character of size bytes, or "." for each byte if s is "".
+func (h *Printable) put(s string, size int) {
+	h.put_as(s, size, h.char)
+}
+
+// put_as is put showing the character with show.
+func (h *Printable) put_as(s string, size int, show func(s string)) {
+	r, _ := utf8.DecodeRuneInString(s)
+	h.place(s, size, h.runeWidth(r), show)
+}
+
+// place shows s of cells cells with show, or "." for each byte if s is "",
+// and pads it with "_" to size bytes. A character that crosses the end of
+// the row is padded to the end of the row and on into the next rows.
+func (h *Printable) place(s string, size, cells int, show func(s string)) {
+	curpos := int(h.cur % uint64(h.width))
+	if s == "" {
+		cells = min(size, h.width-curpos)
+		h.pad1(cells)
+	} else {
+		show(s)
+	}
+	if curpos+size <= h.width {
+		h.pad2(size - cells)
+	} else {
+		// the rest of the row
+		h.pad2(h.width - curpos - cells)
+	}
+	h.cur += uint64(size)
+	if curpos+size >= h.width {
+		h.text(h.end_ch + "\n")
+		for fill := curpos + size - h.width; fill > 0; fill -= h.width {
+			h.text(h.start_ch)
+			h.pad2(min(fill, h.width))
+			if fill >= h.width {
+				h.text(h.end_ch + "\n")
+			}
+		}
+		h.trace.span(size)
+	}
+}
+
+func (h *Printable) bom(width int, is_little bool) {
+	var s string
+	if is_little {
+		s = "LE"
+	} else {
+		s = "BE"
+	}
+	if width == 4 {
+		s = "_" + s + "_"
+	}
+	h.trace.add("bom", s, len(s))
+	fmt.Fprint(h.output, color.GreenString(s))
+}
+
+// sjis_size returns the length of the Shift_JIS sequence at the top of p, 0
+// if p does not start with a valid sequence or -1 if p ends in the middle of
+// it.
+func sjis_size(p []byte) int {
+	if sjis_single(p[0]) {
+		return 1
+	}
+	if !in_range(p[0], 0x81, 0x9f) && !in_range(p[0], 0xe0, 0xfc) {
+		return 0
+	}
+	if len(p) < 2 {
+		return -1
+	}
+	if in_range(p[1], 0x40, 0x7e) || in_range(p[1], 0x80, 0xfc) {
+		return 2
+	}
+	return 0
+}
+
+// sjis_rowcell converts a double-byte Shift_JIS sequence into the plane, row
+// and cell of JIS X 0213 (JIS X 0208 is plane 1).
+func sjis_rowcell(b1, b2 byte) (plane, row, cell int) {
+	plane = 1
+	switch {
+	case b1 <= 0x9f:
+		row = int(b1-0x81)*2 + 1
+	case b1 <= 0xef:
+		row = int(b1-0xe0)*2 + 63
+	case b1 <= 0xf4:
+		// plane 2 rows 1, 8, 3, 4, 5, 12, 13, 14, 15, 78
+		plane = 2
+		row = []int{1, 3, 5, 13, 15}[b1-0xf0]
+	default:
+		plane = 2
+		row = int(b1-0xf5)*2 + 79
+	}
+	switch {
+	case b2 >= 0x9f:
+		row++
+		cell = int(b2-0x9f) + 1
+	case b2 >= 0x80:
+		cell = int(b2 - 0x40)
+	default:
+		cell = int(b2-0x40) + 1
+	}
+	if plane == 2 && b2 >= 0x9f {
+		// the second rows of 0xF0, 0xF2 and 0xF4 are not adjacent
+		switch b1 {
+		case 0xf0:
+			row = 8
+		case 0xf2:
+			row = 12
+		case 0xf4:
+			row = 78
+		}
+	}
+	return plane, row, cell
+}
+
+// jisx0208_chars maps the characters where the tables of x/text follow
+// Microsoft to the ones of JIS X 0208.
+var jisx0208_chars = map[string]string{
+	"\uff5e": "\u301c", // WAVE DASH
+	"\u2225": "\u2016", // DOUBLE VERTICAL LINE
+	"\uff0d": "\u2212", // MINUS SIGN
+	"\uffe0": "\u00a2", // CENT SIGN
+	"\uffe1": "\u00a3", // POUND SIGN
+	"\uffe2": "\u00ac", // NOT SIGN
+}
+
+func jisx0208_char(s string) string {
+	if res, ok := jisx0208_chars[s]; ok {
+		return res
+	}
+	return s
+}
+
+// decode_sjis decodes strict Shift_JIS: JIS X 0208 and half-width kana.
+func decode_sjis(b []byte) string {
+	if len(b) == 2 && !valid_sjis(b[0], b[1]) {
+		return ""
+	}
+	return jisx0208_char(decode_char(japanese.ShiftJIS, b))
+}
+
+// decode_cp932 decodes Windows-31J, which adds the NEC special characters
+// (row 13), the NEC-selected and IBM extensions and a user-defined area to
+// Shift_JIS. The user-defined area is private use, and shown as unprintable.
+func decode_cp932(b []byte) string {
+	return decode_char(japanese.ShiftJIS, b)
+}
+
+// decode_sjis2004 decodes Shift_JIS-2004, the Shift_JIS form of JIS X 0213.
+func decode_sjis2004(b []byte) string {
+	if len(b) == 1 {
+		return decode_char(japanese.ShiftJIS, b)
+	}
+	plane, row, cell := sjis_rowcell(b[0], b[1])
+	if plane == 2 {
+		if r := jisx0213_plane2[byte(row)]; r != nil && r[cell-1] != 0 {
+			return string(r[cell-1])
+		}
+		return ""
+	}
+	return decode_eucjis2004([]byte{byte(row) + 0xa0, byte(cell) + 0xa0})
+}
+
+func valid_eucjp(b1, b2 byte) bool {
+	// info from http://charset.7jp.net/euc.html
+	invalid := []uintrange{
+		{0xa2af, 0xa2b9},
+		{0xa2c2, 0xa2c9},
+		{0xa2d1, 0xa2db},
+		{0xa2eb, 0xa2f1},
+		{0xa2fa, 0xa2fd},
+
+		{0xa3a1, 0xa3af},
+		{0xa3ba, 0xa3c0},
+		{0xa3db, 0xa3e0},
+		{0xa3fb, 0xa3fe},
+
+		{0xa4f4, 0xa4fe},
+
+		{0xa5f7, 0xa5fe},
+
+		{0xa6b9, 0xa6c0},
+		{0xa6d9, 0xa6fe},
+
+		{0xa7c2, 0xa7d0},
+		{0xa7f2, 0xa7fe},
+
+		{0xa8c1, 0xa8fe},
+
+		{0xa9a1, 0xaffe},
+		{0xf4a7, 0xfefe},
+	}
+	ch := (uint(b1) << 8) | uint(b2)
+	for _, r := range invalid {
+		if r.start <= ch && ch <= r.end {
+			slog.Debug("invalid euc-jp", "b1", b1, "b2", b2, "ch", ch, "range", r)
+			return false
+		}
+	}
+	return true
+}
+
+func valid_euckr(b1, b2 byte) bool {
+	// info from https://uic.jp/charset/show/euc-kr/
+	invalid := []uintrange{
+		{0xa2e8, 0xa2ff},
+		{0xa5ab, 0xa5af},
+		{0xa5ba, 0xa5c0},
+		{0xa5d9, 0xa5e0},
+		{0xa5f9, 0xa6a0},
+		{0xa6e5, 0xa7a0},
+		{0xa8a5, 0xa8a5},
+		{0xa8a7, 0xa8a7},
+		{0xa8b0, 0xa8b0},
+		{0xaaf4, 0xaaff},
+		{0xabf7, 0xabff},
+		{0xacc2, 0xacd0},
+		{0xacf2, 0xacff},
+		{0xfdff, 0xffff},
+	}
+	if b2 == 0xa0 || b2 == 0xff {
+		return false
+	}
+	ch := (uint(b1) << 8) | uint(b2)
+	for _, r := range invalid {
+		if r.start <= ch && ch <= r.end {
+			slog.Debug("invalid euc-kr", "b1", b1, "b2", b2, "ch", ch, "range", r)
+			return false
+		}
+	}
+	return true
+}
+
+func valid_euccn(b1, b2 byte) bool {
+	// info from https://uic.jp/charset/show/euc-cn/
+	invalid := []uintrange{
+		{0xa2e3, 0xa2e4},
+		{0xa2ef, 0xa2f0},
+		{0xa2fd, 0xa2ff},
+		{0xa4f4, 0xa4ff},
+		{0xa5f7, 0xa5ff},
+		{0xa6b9, 0xa6c0},
+		{0xa6d9, 0xa7a0},
+		{0xa7c2, 0xa7d0},
+		{0xa7f2, 0xa8a0},
+		{0xa8bb, 0xa8c4},
+		{0xa8ea, 0xa9a3},
+		{0xa9f0, 0xb0a0},
+		{0xf7ff, 0xffff},
+	}
+	if b2 == 0xa0 || b2 == 0xff {
+		return false
+	}
+	ch := (uint(b1) << 8) | uint(b2)
+	for _, r := range invalid {
+		if r.start <= ch && ch <= r.end {
+			slog.Debug("invalid euc-cn", "b1", b1, "b2", b2, "ch", ch, "range", r)
+			return false
+		}
+	}
+	return true
+}
+
+func (h *Printable) writeEUCAny(p []byte, dec *encoding.Decoder, valid func(b1, b2 byte) bool) (n int, err error) {
+	runesrc := append(make([]byte, 0, 2), h.rest...)
+	mb := false
+	for _, ch := range p {
+		if h.cur%uint64(h.width) == 0 && len(runesrc) == 0 {
+			h.text(h.start_ch)
+		}
+		if (0xa1 <= ch && ch <= 0xfe) || ch == 0x8e {
+			runesrc = append(runesrc, ch)
+			if len(runesrc) == 2 {
+				runesrc_u8, err := dec.Bytes(runesrc)
+				if err != nil {
+					h.pad1(2)
+				} else {
+					r, _ := utf8.DecodeRune(runesrc_u8)
+					if !utf8.ValidRune(r) || !valid(runesrc[0], runesrc[1]) {
+						h.pad1(2)
+					} else if unicode.IsPrint(r) {
+						charwidth := h.runeWidth(r)
+						h.char(string(r))
+						if charwidth == 1 {
+							h.pad2(1)
+						}
+					} else {
+						h.pad1(2)
+					}
+				}
+				runesrc = make([]byte, 0, 2)
+				h.cur += 2
+				mb = true
+			} else {
+				continue
+			}
+		} else {
+			if len(runesrc) > 0 {
+				h.pad1(1)
+				h.cur += 1
+				runesrc = make([]byte, 0, 2)
+				if h.cur%uint64(h.width) == 0 {
+					h.text(h.end_ch + "\n")
+				}
+			}
+			if 0x20 <= ch && ch <= 0x7e {
+				h.char(string(ch))
+			} else if !h.control(rune(ch), 1) {
+				h.pad1(1)
+			}
+			h.cur += 1
+			mb = false
+		}
+		if h.cur%uint64(h.width) == 0 {
+			h.text(h.end_ch + "\n")
+		}
+		if mb && h.cur%uint64(h.width) == 1 {
+			h.text("\n" + h.start_ch)
+			h.pad2(1)
+		}
+	}
+	h.rest = runesrc
+	return len(p), nil
+}
+
+// decode_char returns the character of the multibyte sequence src, or "" if
+// it is not a valid or printable character.
+func decode_char(enc encoding.Encoding, src []byte) string {
+	u8, err := enc.NewDecoder().Bytes(src)
+	if err != nil {
+		return ""
+	}
+	r, _ := utf8.DecodeRune(u8)
+	if r == utf8.RuneError || !unicode.IsPrint(r) {
+		return ""
+	}
+	// a few characters of HKSCS and JIS X 0213 are a base and a combining one
+	return string(u8)
+}
+
+// gb18030_size returns the length of the GB18030 sequence at the top of p: 1
+// for ASCII, 2 for GBK and 4 for the four-byte form. It returns 0 if p does
+// not start with a valid sequence and -1 if p ends in the middle of it.
+func gb18030_size(p []byte) int {
+	if p[0] < 0x80 {
+		return 1
+	}
+	if !in_range(p[0], 0x81, 0xfe) {
+		return 0
+	}
+	if len(p) < 2 {
+		return -1
+	}
+	if in_range(p[1], 0x30, 0x39) {
+		if len(p) >= 3 && !in_range(p[2], 0x81, 0xfe) {
+			return 0
+		}
+		if len(p) < 4 {
+			return -1
+		}
+		if in_range(p[3], 0x30, 0x39) {
+			return 4
+		}
+		return 0
+	}
+	if in_range(p[1], 0x40, 0x7e) || in_range(p[1], 0x80, 0xfe) {
+		return 2
+	}
+	return 0
+}
+
+// writeMBCS shows a multibyte encoding without state: size tells the length
+// of the sequence at the top of the bytes like gb18030_size, and decode
+// returns its character like decode_char.
+func (h *Printable) writeMBCS(p []byte, size func(p []byte) int, decode func(b []byte) string) (n int, err error) {
+	buf := append(h.rest, p...)
+	for len(buf) > 0 {
+		n := size(buf)
+		if n == -1 {
+			break
+		}
+		if h.cur%uint64(h.width) == 0 {
+			h.text(h.start_ch)
+		}
+		switch {
+		case n == 0:
+			n = 1
+			h.put("", 1)
+		case n == 1 && buf[0] < 0x80:
+			if 0x20 <= buf[0] && buf[0] <= 0x7e {
+				h.put(string(buf[0]), 1)
+			} else {
+				h.put_control(rune(buf[0]), 1)
+			}
+		default:
+			h.put(decode(buf[:n]), n)
+		}
+		buf = buf[n:]
+	}
+	h.rest = bytes.Clone(buf)
+	return len(p), nil
+}
+
+func decode_gb18030(b []byte) string {
+	return decode_char(simplifiedchinese.GB18030, b)
+}
+
+// gbk_size is gb18030_size for CP936 (GBK), which has no four-byte form and
+// has the euro sign at 0x80.
+func gbk_size(p []byte) int {
+	switch {
+	case p[0] <= 0x80:
+		return 1
+	case p[0] == 0xff:
+		return 0
+	case len(p) < 2:
+		return -1
+	case in_range(p[1], 0x40, 0x7e) || in_range(p[1], 0x80, 0xfe):
+		return 2
+	}
+	return 0
+}
+
+func decode_cp936(b []byte) string {
+	return decode_char(simplifiedchinese.GBK, b)
+}
+
+// eucjp_size returns the length of the EUC-JP sequence at the top of p: 1
+// for ASCII, 2 for JIS X 0208 and half-width kana (SS2 0x8E) and 3 for JIS X
+// 0212 (SS3 0x8F). It returns 0 if p does not start with a valid sequence and
+// -1 if p ends in the middle of it.
+func eucjp_size(p []byte) int {
+	size := 2
+	switch {
+	case p[0] < 0x80:
+		return 1
+	case p[0] == 0x8f:
+		size = 3
+	case p[0] != 0x8e && !in_range(p[0], 0xa1, 0xfe):
+		return 0
+	}
+	for i := 1; i < size; i++ {
+		if i == len(p) {
+			return -1
+		}
+		if (p[0] == 0x8e && !in_range(p[i], 0xa1, 0xdf)) || !in_range(p[i], 0xa1, 0xfe) {
+			return 0
+		}
+	}
+	return size
+}
+
+func decode_eucjp(b []byte) string {
+	if len(b) == 2 && b[0] != 0x8e && !valid_eucjp(b[0], b[1]) {
+		return ""
+	}
+	return decode_char(japanese.EUCJP, b)
+}
+
+// decode_eucjis2004 decodes JIS X 0213: plane 1 is JIS X 0208 and the
+// characters added to it, and plane 2 takes the place of JIS X 0212.
+func decode_eucjis2004(b []byte) string {
+	switch {
+	case len(b) == 3:
+		if row := jisx0213_plane2[b[1]-0xa0]; row != nil && row[b[2]-0xa1] != 0 {
+			return string(row[b[2]-0xa1])
+		}
+		return ""
+	case b[0] == 0x8e:
+		return decode_eucjp(b)
+	}
+	if pair, ok := jisx0213_pairs[uint16(b[0]-0xa0)<<8|uint16(b[1]-0xa0)]; ok {
+		return pair
+	}
+	if row := jisx0213_plane1[b[0]-0xa0]; row != nil && row[b[1]-0xa1] != 0 {
+		return string(row[b[1]-0xa1])
+	}
+	return jisx0208_char(decode_eucjp(b))
+}
+
+func (h *Printable) writeEUCKR(p []byte) (n int, err error) {
+	dec := korean.EUCKR.NewDecoder()
+	return h.writeEUCAny(p, dec, valid_euckr)
+}
+
+func (h *Printable) writeEUCCN(p []byte) (n int, err error) {
+	dec := simplifiedchinese.GB18030.NewDecoder()
+	return h.writeEUCAny(p, dec, valid_euccn)
+}
+
+// uhc_size returns the length of the CP949 (Unified Hangul Code) sequence at
+// the top of p like gb18030_size. The extended hangul take trail bytes that
+// EUC-KR does not use.
+func uhc_size(p []byte) int {
+	switch {
+	case p[0] < 0x80:
+		return 1
+	case !in_range(p[0], 0x81, 0xfe):
+		return 0
+	case len(p) < 2:
+		return -1
+	case in_range(p[1], 0x41, 0x5a) || in_range(p[1], 0x61, 0x7a) || in_range(p[1], 0x81, 0xfe):
+		return 2
+	}
+	return 0
+}
+
+func decode_cp949(b []byte) string {
+	return decode_char(korean.EUCKR, b)
+}
+
+// big5_size returns the length of the Big5 sequence at the top of p like
+// gb18030_size, with the lead bytes of HKSCS.
+func big5_size(p []byte) int {
+	switch {
+	case p[0] < 0x80:
+		return 1
+	case !in_range(p[0], 0x81, 0xfe):
+		return 0
+	case len(p) < 2:
+		return -1
+	case in_range(p[1], 0x40, 0x7e) || in_range(p[1], 0xa1, 0xfe):
+		return 2
+	}
+	return 0
+}
+
+// decode_cp950 decodes Big5 with the euro sign and the ETEN extensions of
+// Microsoft. The user-defined areas and HKSCS are shown as unprintable.
+func decode_cp950(b []byte) string {
+	// info from the CP950 table of Microsoft: 0xC6A1-0xC8FE is user-defined
+	valid := []uintrange{
+		{0xa140, 0xa3bf},
+		{0xa3e1, 0xa3e1},
+		{0xa440, 0xc67e},
+		{0xc940, 0xf9fe},
+	}
+	ch := (uint(b[0]) << 8) | uint(b[1])
+	if ch == 0xf9fe {
+		// HKSCS has U+FFED here
+		return "\u2593"
+	}
+	for _, r := range valid {
+		if r.start <= ch && ch <= r.end {
+			return decode_char(traditionalchinese.Big5, b)
+		}
+	}
+	return ""
+}
+
+// decode_big5hkscs decodes Big5 with the Hong Kong Supplementary Character
+// Set, as the big5 decoder of x/text does.
+func decode_big5hkscs(b []byte) string {
+	return decode_char(traditionalchinese.Big5, b)
+}
+
+func valid_big5(b1, b2 byte) bool {
+	return true
+}
+
+func (h *Printable) writeBig5(p []byte) (n int, err error) {
+	dec := traditionalchinese.Big5.NewDecoder()
+	runesrc := append(make([]byte, 0, 2), h.rest...)
+	mb := false
+	for _, ch := range p {
+		if h.cur%uint64(h.width) == 0 && len(runesrc) == 0 {
+			h.text(h.start_ch)
+		}
+		if len(runesrc) == 0 && 0xa1 <= ch && ch <= 0xf9 {
+			runesrc = append(runesrc, ch)
+			continue
+		} else if len(runesrc) == 1 && 0x40 <= ch && ch <= 0xfe {
+			runesrc = append(runesrc, ch)
+			runesrc_u8, err := dec.Bytes(runesrc)
+			if err != nil {
+				h.pad1(2)
+			} else {
+				r, _ := utf8.DecodeRune(runesrc_u8)
+				if !utf8.ValidRune(r) || !valid_big5(runesrc[0], runesrc[1]) {
+					h.pad1(2)
+				} else if unicode.IsPrint(r) {
+					charwidth := h.runeWidth(r)
+					h.char(string(r))
+					if charwidth == 1 {
+						h.pad2(1)
+					}
+				} else {
+					h.pad1(2)
+				}
+			}
+			runesrc = make([]byte, 0, 2)
+			h.cur += 2
+			mb = true
+		} else {
+			if len(runesrc) > 0 {
+				h.pad1(1)
+				h.cur += 1
+				runesrc = make([]byte, 0, 2)
+				if h.cur%uint64(h.width) == 0 {
+					h.text(h.end_ch + "\n")
+				}
+			}
+			if 0x20 <= ch && ch <= 0x7e {
+				h.char(string(ch))
+			} else if !h.control(rune(ch), 1) {
+				h.pad1(1)
+			}
+			h.cur += 1
+			mb = false
+		}
+		if h.cur%uint64(h.width) == 0 {
+			h.text(h.end_ch + "\n")
+		}
+		if mb && h.cur%uint64(h.width) == 1 {
+			h.text("\n" + h.start_ch)
+			h.pad2(1)
+		}
+	}
+	h.rest = runesrc
+	return len(p), nil
+}
+
+func (h *Printable) writeASCII(p []byte) (n int, err error) {
+	for _, ch := range p {
+		if h.cur%uint64(h.width) == 0 {
+			h.text(h.start_ch)
+		}
+		if 0x20 <= ch && ch <= 0x7e {
+			h.char(string(ch))
+		} else if !h.control(rune(ch), 1) {
+			h.pad1(1)
+		}
+		h.cur += 1
+		if h.cur%uint64(h.width) == 0 {
+			h.text(h.end_ch + "\n")
+		}
+	}
+	return len(p), nil
+}
+
+func (h *Printable) runeWidth(r rune) int {
+	return runeWidth(r)
+}
+
+func runeWidth(r rune) int {
+	prop := width.LookupRune(r)
+	switch prop.Kind() {
+	case width.EastAsianWide, width.EastAsianFullwidth:
+		return 2
+	default:
+		return 1
+	}
+}
+
+func (h *Printable) writeUTF8(p []byte) (n int, err error) {
+	h.rest = append(h.rest, p...)
+	h.utf8_flush(false)
+	return len(p), nil
+}
+
+// utf8_flush shows the grapheme clusters in h.rest, or each code point if
+// components is set or the cluster is longer than grapheme_max. The last
+// cluster stays in h.rest until the character after it shows where it ends,
+// unless final is set.
+func (h *Printable) utf8_flush(final bool) {
+	for len(h.rest) > 0 {
+		r, size := utf8.DecodeRune(h.rest)
+		if r == utf8.RuneError && size == 1 {
+			if !final && !utf8.FullRune(h.rest) {
+				return
+			}
+		} else if !h.components && !(h.split && continues_cluster(r)) {
+			size, h.split = grapheme_size(h.rest, final)
+			if size == 0 {
+				return
+			}
+		}
+		if h.cur%uint64(h.width) == 0 {
+			h.text(h.start_ch)
+		}
+		if control_text(h.control_style, r) != "" {
+			// a control is shown by itself, even CR LF
+			h.put_control(r, 1)
+			h.rest = h.rest[1:]
+			continue
+		}
+		s := ""
+		if r != utf8.RuneError || size > 1 {
+			s = grapheme_text(string(h.rest[:size]))
+		}
+		h.place(s, size, grapheme_width(s), func(s string) { h.glyph(s, grapheme_width(s)) })
+		h.rest = h.rest[size:]
+	}
+}
+
+func getcode_utf16(p []byte, lendian bool) (uint32, error) {
+	if len(p) < 2 {
+		return 0, fmt.Errorf("short bytes: %d", len(p))
+	}
+	if lendian {
+		return uint32(p[1])<<8 | uint32(p[0]), nil
+	}
+	return uint32(p[0])<<8 | uint32(p[1]), nil
+}
+
+func (h *Printable) writeUTF16(p []byte) (n int, err error) {
+	written := len(p)
+	p = append(h.rest, p...)
+	h.rest = nil
+	if len(p) < 2 {
+		h.rest = p
+		return written, nil
+	}
+	// check bom
+	cur := 0
+	if h.cur == 0 && p[0] == 0xff && p[1] == 0xfe {
+		h.lendian = true
+		h.text(h.start_ch)
+		cur = 2
+		h.bom(2, h.lendian)
+	} else if h.cur == 0 && p[0] == 0xfe && p[1] == 0xff {
+		h.lendian = false
+		h.text(h.start_ch)
+		cur = 2
+		h.bom(2, h.lendian)
+	}
+	for cur < len(p) {
+		skip := 0
+		pos := int((h.cur + uint64(cur)) % uint64(h.width))
+		if pos == 0 {
+			h.text(h.start_ch)
+		}
+		code, err := getcode_utf16(p[cur:], h.lendian)
+		if err != nil {
+			break
+		}
+		if code&0b1111_1100_0000_0000 == 0b1101_1000_0000_0000 {
+			// surrogate pair 1st?
+			code2, err := getcode_utf16(p[cur+2:], h.lendian)
+			if err != nil {
+				break
+			} else if code2&0b1111_1100_0000_0000 == 0b1101_1100_0000_0000 {
+				// surrogate pair 2nd
+				rcode := 0x10000 + ((code & 0b0000_0011_1111_1111) << 10) | (code2 & 0b0000_0011_1111_1111)
+				slog.Debug("rune", "code1", code, "code2", code2, "rune", rcode)
+				h.char(string(rune(rcode)))
+				if pos+4 < h.width {
+					h.pad2(2)
+				}
+				skip = 4
+			} else {
+				h.pad1(1)
+				skip = 1
+			}
+		} else if code < 0x10000 {
+			ch := rune(code)
+			if unicode.IsPrint(ch) {
+				charwidth := h.runeWidth(ch)
+				if charwidth == 1 && pos+1 < h.width {
+					h.char(string(ch))
+					h.pad2(1)
+				} else {
+					h.char(string(ch))
+				}
+			} else if !h.control(ch, 2) {
+				h.pad1(2)
+			}
+			skip = 2
+		}
+		if pos+skip >= h.width {
+			h.text(h.end_ch + "\n")
+		}
+		if pos+skip > h.width {
+			h.text(h.start_ch)
+			h.pad2(pos + skip - h.width)
+		}
+		cur += skip
+	}
+	h.cur += uint64(cur)
+	h.rest = p[cur:]
+	return written, nil
+}
+
+func getcode_utf32(p []byte, lendian bool) (uint32, error) {
+	if len(p) < 4 {
+		return 0, fmt.Errorf("short bytes: %d", len(p))
+	}
+	if lendian {
+		return uint32(p[3])<<24 | uint32(p[2])<<16 | uint32(p[1])<<8 | uint32(p[0]), nil
+	}
+	return uint32(p[0])<<24 | uint32(p[1])<<16 | uint32(p[2])<<8 | uint32(p[3]), nil
+}
+
+func (h *Printable) writeUTF32(p []byte) (n int, err error) {
+	written := len(p)
+	p = append(h.rest, p...)
+	h.rest = nil
+	if len(p) < 4 {
+		h.rest = p
+		return written, nil
+	}
+	// check bom
+	cur := 0
+	if h.cur == 0 && bytes.Equal(p[:4], []byte{0x00, 0x00, 0xfe, 0xff}) {
+		h.lendian = false
+		h.text(h.start_ch)
+		cur = 4
+		h.bom(4, h.lendian)
+	} else if h.cur == 0 && bytes.Equal(p[:4], []byte{0xff, 0xfe, 0x00, 0x00}) {
+		h.lendian = true
+		h.text(h.start_ch)
+		cur = 4
+		h.bom(4, h.lendian)
+	}
+	for cur < len(p) {
+		skip := 0
+		pos := int((h.cur + uint64(cur)) % uint64(h.width))
+		if pos == 0 {
+			h.text(h.start_ch)
+		}
+		code, err := getcode_utf32(p[cur:], h.lendian)
+		if err != nil {
+			break
+		}
+		if code <= 0x10ffff {
+			ch := rune(code)
+			if unicode.IsPrint(ch) {
+				charwidth := h.runeWidth(ch)
+				h.char(string(ch))
+				if pos+charwidth < h.width {
+					h.pad2(4 - charwidth)
+				} else {
+					h.pad2(h.width - (pos + charwidth))
+				}
+			} else if !h.control(ch, 4) {
+				h.pad1(1)
+				h.pad2(3)
+			}
+			skip = 4
+		} else {
+			h.pad1(1)
+			skip = 1
+		}
+		if pos+skip >= h.width {
+			h.text(h.end_ch + "\n")
+		}
+		if pos+skip > h.width {
+			h.text(h.start_ch)
+			h.pad2(pos + skip - h.width)
+		}
+		cur += skip
+	}
+	h.cur += uint64(cur)
+	h.rest = p[cur:]
+	return written, nil
+}
+
+func (h *Printable) writeAny(p []byte, dec *encoding.Decoder, valid func(b []byte) bool) (n int, err error) {
+	runesrc := make([]byte, 0, 2)
+	runesrc = append(h.rest, runesrc...)
+	mb := false
+	for _, ch := range p {
+		skip := 0
+		if h.cur%uint64(h.width) == 0 {
+			h.text(h.start_ch)
+		}
+		runesrc = append(runesrc, ch)
+		runesrc_u8, err := dec.Bytes(runesrc)
+		if err != nil && len(runesrc) <= 3 {
+			continue
+		}
+		if err != nil {
+			h.pad1(2)
+			runesrc = runesrc[1:]
+			skip = 1
+		} else {
+			r, _ := utf8.DecodeRune(runesrc_u8)
+			skip = len(runesrc_u8)
+			runesrc = make([]byte, 0, 2)
+			if !utf8.ValidRune(r) || !valid(runesrc_u8) {
+				h.pad1(len(runesrc_u8))
+			} else if unicode.IsPrint(r) {
+				charwidth := h.runeWidth(r)
+				h.char(string(r))
+				if charwidth == 1 {
+					h.pad2(len(runesrc_u8) - charwidth)
+				}
+			} else if !h.control(r, len(runesrc_u8)) {
+				h.pad1(len(runesrc_u8))
+			}
+		}
+		h.cur += uint64(skip)
+		if h.cur%uint64(h.width) == 0 {
+			h.text(h.end_ch + "\n")
+		}
+		if mb && h.cur%uint64(h.width) == 1 {
+			h.text("\n" + h.start_ch)
+			h.pad2(1)
+		}
+	}
+	h.rest = runesrc
+	return len(p), nil
+}
+
+// encoding_names lists the names accepted by Printable.Write, one line per
+// encoding. Single-byte encodings from charmap.All are accepted as well.
+var encoding_names = [][]string{
+	{"ascii", "us-ascii"},
+	{"utf-8", "utf8"},
+	{"utf-16", "utf16", "utf-16be", "utf16be", "utf-16le", "utf16le"},
+	{"utf-32", "utf32", "utf-32be", "utf32be", "utf-32le", "utf32le"},
+	{"cesu-8", "cesu8"},
+	{"mutf-8", "mutf8", "java-modified-utf-8"},
+	{"wtf-8", "wtf8"},
+	{"utf-7", "utf7"},
+	{"euc-jp", "eucjp"},
+	{"euc-jis-2004", "euc-jisx0213", "eucjis2004"},
+	{"euc-kr", "euckr"},
+	{"euc-cn", "euccn", "gb2312"},
+	{"gb18030"},
+	{"cp936", "gbk", "windows-936"},
+	{"big5"},
+	{"cp949", "uhc", "windows-949"},
+	{"cp950", "windows-950"},
+	{"big5-hkscs", "big5hkscs", "hkscs"},
+	{"shift-jis", "sjis", "shiftjis", "shift_jis"},
+	{"cp932", "cp-932", "windows-31j", "ms932"},
+	{"shift_jis-2004", "shift-jis-2004", "sjis-2004", "shift_jisx0213"},
+	{"iso-2022-jp", "iso2022jp", "csiso2022jp", "jis"},
+	{"iso-2022-kr", "iso2022kr", "csiso2022kr"},
+	{"iso-2022-cn", "iso2022cn", "csiso2022cn"},
+	{"ibm930", "cp930", "ibm-930"},
+	{"ibm939", "cp939", "ibm-939"},
+	{"ibm1390", "cp1390", "ibm-1390"},
+	{"ibm1399", "cp1399", "ibm-1399"},
+	{"ibm933", "cp933", "ibm-933"},
+	{"ibm935", "cp935", "ibm-935"},
+	{"ibm937", "cp937", "ibm-937"},
+}
+
+func charmap_name(cm encoding.Encoding) string {
+	name := fmt.Sprintf("%s", cm)
+	if strings.Contains(name, "enc=") {
+		tok := strings.SplitN(name, "enc=", 2)
+		if len(tok) == 2 {
+			name = strings.Trim(tok[1], "\"")
+		}
+	}
+	return name
+}
+
+func lookup_charmap(name string) encoding.Encoding {
+	for _, cm := range charmap.All {
+		if strings.EqualFold(charmap_name(cm), name) {
+			return cm
+		}
+	}
+	return nil
+}
+
+// Encodings lists the accepted encodings, one line per encoding with its
+// aliases, starting with "auto".
+func Encodings() []string {
+	res := []string{"auto"}
+	for _, names := range encoding_names {
+		res = append(res, strings.Join(names, ", "))
+	}
+	for _, cm := range charmap.All {
+		res = append(res, charmap_name(cm))
+	}
+	return res
+}
+
+// LookupEncoding returns the x/text encoding for name, or nil if unknown.
+// UTF-16/32 without a byte order are big endian, as in Printable.Write.
+func LookupEncoding(name string) encoding.Encoding {
+	switch strings.ToLower(name) {
+	case "ascii", "us-ascii", "utf-8", "utf8":
+		return encoding.Nop
+	case "utf-16", "utf16", "utf-16be", "utf16be":
+		return xunicode.UTF16(xunicode.BigEndian, xunicode.IgnoreBOM)
+	case "utf-16le", "utf16le":
+		return xunicode.UTF16(xunicode.LittleEndian, xunicode.IgnoreBOM)
+	case "utf-32", "utf32", "utf-32be", "utf32be":
+		return utf32.UTF32(utf32.BigEndian, utf32.IgnoreBOM)
+	case "utf-32le", "utf32le":
+		return utf32.UTF32(utf32.LittleEndian, utf32.IgnoreBOM)
+	case "euc-jp", "eucjp":
+		return japanese.EUCJP
+	case "euc-kr", "euckr":
+		return korean.EUCKR
+	case "euc-cn", "euccn", "gb2312", "gb18030":
+		return simplifiedchinese.GB18030
+	case "cp936", "gbk", "windows-936":
+		return simplifiedchinese.GBK
+	case "cp949", "uhc", "windows-949":
+		return korean.EUCKR
+	case "cp950", "windows-950", "big5-hkscs", "big5hkscs", "hkscs":
+		return traditionalchinese.Big5
+	case "big5":
+		return traditionalchinese.Big5
+	case "shift-jis", "sjis", "shiftjis", "shift_jis", "cp932", "cp-932", "windows-31j", "ms932":
+		return japanese.ShiftJIS
+	}
+	return lookup_charmap(name)
+}
+
+// ValidEncoding tells if name is accepted as the encoding of a printable
+// column.
+func ValidEncoding(name string) bool {
+	for _, names := range encoding_names {
+		for _, n := range names {
+			if strings.EqualFold(n, name) {
+				return true
+			}
+		}
+	}
+	return lookup_charmap(name) != nil
+}
+
+func (h *Printable) Write(p []byte) (n int, err error) {
+	switch strings.ToLower(h.encoding) {
+	case "utf-8", "utf8":
+		return h.writeUTF8(p)
+	case "utf-16", "utf16":
+		return h.writeUTF16(p)
+	case "utf-16be", "utf16be":
+		h.lendian = false
+		return h.writeUTF16(p)
+	case "utf-16le", "utf16le":
+		h.lendian = true
+		return h.writeUTF16(p)
+	case "utf-32", "utf32":
+		return h.writeUTF32(p)
+	case "utf-32be", "utf32be":
+		h.lendian = false
+		return h.writeUTF32(p)
+	case "utf-32le", "utf32le":
+		h.lendian = true
+		return h.writeUTF32(p)
+	case "cesu-8", "cesu8", "mutf-8", "mutf8", "java-modified-utf-8", "wtf-8", "wtf8":
+		return h.writeUTF8Variant(p, utf8_variant(h.encoding))
+	case "utf-7", "utf7":
+		return h.writeUTF7(p)
+	case "euc-jp", "eucjp":
+		return h.writeMBCS(p, eucjp_size, decode_eucjp)
+	case "euc-jis-2004", "euc-jisx0213", "eucjis2004":
+		return h.writeMBCS(p, eucjp_size, decode_eucjis2004)
+	case "euc-kr", "euckr":
+		return h.writeEUCKR(p)
+	case "euc-cn", "euccn", "gb2312":
+		return h.writeEUCCN(p)
+	case "gb18030":
+		return h.writeMBCS(p, gb18030_size, decode_gb18030)
+	case "cp936", "gbk", "windows-936":
+		return h.writeMBCS(p, gbk_size, decode_cp936)
+	case "cp949", "uhc", "windows-949":
+		return h.writeMBCS(p, uhc_size, decode_cp949)
+	case "cp950", "windows-950":
+		return h.writeMBCS(p, big5_size, decode_cp950)
+	case "big5-hkscs", "big5hkscs", "hkscs":
+		return h.writeMBCS(p, big5_size, decode_big5hkscs)
+	case "big5":
+		return h.writeBig5(p)
+	case "shift-jis", "sjis", "shiftjis", "shift_jis":
+		return h.writeMBCS(p, sjis_size, decode_sjis)
+	case "cp932", "cp-932", "windows-31j", "ms932":
+		return h.writeMBCS(p, sjis_size, decode_cp932)
+	case "shift_jis-2004", "shift-jis-2004", "sjis-2004", "shift_jisx0213":
+		return h.writeMBCS(p, sjis_size, decode_sjis2004)
+	}
+	if variant := iso2022_variant(h.encoding); variant != "" {
+		return h.writeISO2022(p, variant)
+	}
+	if cp, ok := ebcdic_lookup(h.encoding); ok {
+		return h.writeEBCDIC(p, cp)
+	}
+	if cm := lookup_charmap(h.encoding); cm != nil {
+		dec := cm.NewDecoder()
+		slog.Debug("using decoder", "name", charmap_name(cm))
+		return h.writeAny(p, dec, func(b []byte) bool { return true })
+	}
+	slog.Debug("using ascii")
+	return h.writeASCII(p)
+}
+
+// SetOffset starts the column at offset, leaving blanks for the bytes before
+// it in the first row.
+func (h *Printable) SetOffset(offset uint64) {
+	h.cur = offset
+	pos := int(offset % uint64(h.width))
+	if h.trace != nil {
+		h.trace.row_start = offset - uint64(pos)
+		h.trace.cell = pos
+	}
+	if pos != 0 {
+		fmt.Fprint(h.output, h.start_ch+strings.Repeat(" ", pos))
+	}
+}
+
+func (h *Printable) Close() (err error) {
+	switch strings.ToLower(h.encoding) {
+	case "utf-8", "utf8":
+		h.utf8_flush(true)
+	}
+	// the incomplete character left at the end is shown as invalid bytes
+	for idx := range h.rest {
+		if idx != 0 && h.cur%uint64(h.width) == 0 {
+			h.text(h.start_ch)
+		}
+		h.pad1(1)
+		h.cur += 1
+		if h.cur%uint64(h.width) == 0 {
+			h.text(h.end_ch + "\n")
+		}
+	}
+	h.rest = nil
+	if h.cur%uint64(h.width) != 0 {
+		h.text("\n")
+	}
+	if closer, ok := h.output.(io.Closer); ok {
+		if err := closer.Close(); err != nil {
+			slog.Error("close writer", "err", err)
+			return err
+		}
+	}
+	return nil
+}
+
+func NewPrintable(output io.Writer, encoding string, width int) *Printable {
+	return &Printable{
+		output:   output,
+		cur:      0,
+		width:    width,
+		encoding: encoding,
+		rest:     make([]byte, 0),
+		lendian:  false,
+	}
+}
+
+func NewPrintableSep(output io.Writer, encoding string, width int, start_ch, end_ch string) *Printable {
+	return &Printable{
+		output:   output,
+		cur:      0,
+		width:    width,
+		encoding: encoding,
+		rest:     make([]byte, 0),
+		start_ch: start_ch,
+		end_ch:   end_ch,
+		lendian:  false,
+	}
+}
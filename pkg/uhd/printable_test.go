@@ -0,0 +1,928 @@
+package uhd
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+)
+
+func TestPrintable_WriteASCII(t *testing.T) {
+	buf := &bytes.Buffer{}
+	p := NewPrintable(buf, "ascii", 8)
+	input1 := []byte("Hello,")
+	input2 := []byte("\x01!")
+	_, err := p.Write(input1)
+	if err != nil {
+		t.Fatalf("Write error: %v", err)
+	}
+	_, err = p.Write(input2)
+	if err != nil {
+		t.Fatalf("Write error: %v", err)
+	}
+	if err = p.Close(); err != nil {
+		t.Error("close", "err", err)
+	}
+	expected := "Hello,.!\n"
+	if buf.String() != expected {
+		t.Errorf("unexpected output:\ngot:  %q\nwant: %q", buf.String(), expected)
+	}
+}
+
+//nolint:gosmopolitan
+func TestPrintable_WriteUTF8(t *testing.T) {
+	buf := &bytes.Buffer{}
+	p := NewPrintable(buf, "utf-8", 8)
+	input1 := []byte("こんにちは世")
+	input2 := []byte("界\x00abc\x01\x7f!")
+	_, err := p.Write(input1)
+	if err != nil {
+		t.Fatalf("Write error: %v", err)
+	}
+	_, err = p.Write(input2)
+	if err != nil {
+		t.Fatalf("Write error: %v", err)
+	}
+	if err = p.Close(); err != nil {
+		t.Error("close", "err", err)
+	}
+	expected := "こ_ん_に\n_ち_は_世\n__界_.ab\nc..!\n"
+	if buf.String() != expected {
+		t.Errorf("unexpected output:\ngot:  %q\nwant: %q", buf.String(), expected)
+	}
+}
+
+//nolint:gosmopolitan
+func TestPrintable_WriteUTF8_kr(t *testing.T) {
+	buf := &bytes.Buffer{}
+	p := NewPrintable(buf, "utf-8", 8)
+	input := []byte("안녕히계십시오")
+	_, err := p.Write(input)
+	if err != nil {
+		t.Fatalf("Write error: %v", err)
+	}
+	if err = p.Close(); err != nil {
+		t.Error("close", "err", err)
+	}
+	expected := "안_녕_히\n_계_십_시\n__오_\n"
+	if buf.String() != expected {
+		t.Errorf("unexpected output:\ngot:  %q\nwant: %q", buf.String(), expected)
+	}
+}
+
+//nolint:gosmopolitan
+func TestPrintable_WriteUTF8_emoji(t *testing.T) {
+	buf := &bytes.Buffer{}
+	p := NewPrintable(buf, "utf-8", 16)
+	input := []byte("💩や🍺などの絵文字")
+	_, err := p.Write(input)
+	if err != nil {
+		t.Fatalf("Write error: %v", err)
+	}
+	if err = p.Close(); err != nil {
+		t.Error("close", "err", err)
+	}
+	expected := "💩__や_🍺__な_ど\n_の_絵_文_字_\n"
+	if buf.String() != expected {
+		t.Errorf("unexpected output:\ngot:  %q\nwant: %q", buf.String(), expected)
+	}
+}
+
+//nolint:gosmopolitan
+func TestPrintable_WriteUTF8_hankana(t *testing.T) {
+	buf := &bytes.Buffer{}
+	p := NewPrintable(buf, "utf-8", 16)
+	// the voiced sound mark is in the grapheme cluster of "ｼ"
+	input := []byte("ﾊﾝｶｸｶﾅﾓｼﾞ")
+	_, err := p.Write(input)
+	if err != nil {
+		t.Fatalf("Write error: %v", err)
+	}
+	if err = p.Close(); err != nil {
+		t.Error("close", "err", err)
+	}
+	expected := "ﾊ__ﾝ__ｶ__ｸ__ｶ__ﾅ\n__ﾓ__ｼﾞ____\n"
+	if buf.String() != expected {
+		t.Errorf("unexpected output:\ngot:  %q\nwant: %q", buf.String(), expected)
+	}
+}
+
+//nolint:gosmopolitan
+func TestPrintable_WriteEUCJP(t *testing.T) {
+	buf := &bytes.Buffer{}
+	p := NewPrintable(buf, "euc-jp", 8)
+	input1 := []byte{0xA4, 0xB3, 0xA4, 0xF3, 0xA4}
+	input2 := []byte{0xCB, 0xA4, 0xC1, 0xA4, 0xCF, 0xC0, 0xA4, 0xB3, 0xA6, 0x0A, 0x61, 0x62, 0x63, 0x01, 0x7F, 0x21, 0x0A}
+	_, err := p.Write(input1)
+	if err != nil {
+		t.Fatalf("Write error: %v", err)
+	}
+	_, err = p.Write(input2)
+	if err != nil {
+		t.Fatalf("Write error: %v", err)
+	}
+	if err = p.Close(); err != nil {
+		t.Error("close", "err", err)
+	}
+	expected := "こんにち\nは世界.a\nbc..!.\n"
+	if buf.String() != expected {
+		t.Errorf("unexpected output:\ngot:  %q\nwant: %q", buf.String(), expected)
+	}
+}
+
+//nolint:gosmopolitan
+func TestPrintable_WriteEUCJP_hankaku(t *testing.T) {
+	buf := &bytes.Buffer{}
+	p := NewPrintable(buf, "euc-jp", 8)
+	input1 := []byte{0x8e, 0xca, 0x8e, 0xdd, 0x8e}
+	input2 := []byte{0xb6, 0x8e, 0xb8, 0x8e, 0xb6, 0x8e, 0xc5, 0x8e, 0xd3, 0x8e, 0xbc, 0x8e, 0xde}
+	_, err := p.Write(input1)
+	if err != nil {
+		t.Fatalf("Write error: %v", err)
+	}
+	_, err = p.Write(input2)
+	if err != nil {
+		t.Fatalf("Write error: %v", err)
+	}
+	if err = p.Close(); err != nil {
+		t.Error("close", "err", err)
+	}
+	expected := "ﾊ_ﾝ_ｶ_ｸ_\nｶ_ﾅ_ﾓ_ｼ_\nﾞ_\n"
+	if buf.String() != expected {
+		t.Errorf("unexpected output:\ngot:  %q\nwant: %q", buf.String(), expected)
+	}
+}
+
+//nolint:gosmopolitan
+func TestPrintable_WriteShiftJIS(t *testing.T) {
+	buf := &bytes.Buffer{}
+	p := NewPrintable(buf, "shift-jis", 8)
+	input1 := []byte{0x82, 0xb1, 0x82, 0xf1, 0x82, 0xc9, 0x82}
+	input2 := []byte{0xbf, 0x82, 0xcd, 0x90, 0xa2, 0x8a, 0x45, 0x00, 0x61, 0x62, 0x63, 0x01, 0x7f, 0x21, 0x0a}
+	_, err := p.Write(input1)
+	if err != nil {
+		t.Fatalf("Write error: %v", err)
+	}
+	_, err = p.Write(input2)
+	if err != nil {
+		t.Fatalf("Write error: %v", err)
+	}
+	if err = p.Close(); err != nil {
+		t.Error("close", "err", err)
+	}
+	expected := "こんにち\nは世界.a\nbc..!.\n"
+	if buf.String() != expected {
+		t.Errorf("unexpected output:\ngot:  %q\nwant: %q", buf.String(), expected)
+	}
+}
+
+//nolint:gosmopolitan
+func TestPrintable_WriteShiftJIS_hankaku(t *testing.T) {
+	buf := &bytes.Buffer{}
+	p := NewPrintable(buf, "shift-jis", 8)
+	input1 := []byte{0xca, 0xdd, 0xb6}
+	input2 := []byte{0xb8, 0xb6, 0xc5, 0xd3, 0xbc, 0xde}
+	_, err := p.Write(input1)
+	if err != nil {
+		t.Fatalf("Write error: %v", err)
+	}
+	_, err = p.Write(input2)
+	if err != nil {
+		t.Fatalf("Write error: %v", err)
+	}
+	if err = p.Close(); err != nil {
+		t.Error("close", "err", err)
+	}
+	expected := "ﾊﾝｶｸｶﾅﾓｼ\nﾞ\n"
+	if buf.String() != expected {
+		t.Errorf("unexpected output:\ngot:  %q\nwant: %q", buf.String(), expected)
+	}
+}
+
+//nolint:gosmopolitan
+func TestPrintable_WriteUTF16(t *testing.T) {
+	buf := &bytes.Buffer{}
+	p := NewPrintable(buf, "utf16", 8)
+	input1 := []byte{0xfe, 0xff, 0x30, 0x53, 0x30}
+	input2 := []byte{0x93, 0x30, 0x6b, 0x30, 0x61, 0x30, 0x6f, 0x4e, 0x16, 0x75, 0x4c,
+		0x00, 0x00, 0x00, 0x61, 0x00, 0x62, 0x00, 0x63, 0x00, 0x01, 0x00, 0x7f, 0x00, 0x21, 0x00, 0x0a}
+	_, err := p.Write(input1)
+	if err != nil {
+		t.Fatalf("Write error: %v", err)
+	}
+	_, err = p.Write(input2)
+	if err != nil {
+		t.Fatalf("Write error: %v", err)
+	}
+	if err = p.Close(); err != nil {
+		t.Error("close", "err", err)
+	}
+	expected := "BEこんに\nちは世界\n..a_b_c_\n....!_..\n"
+	if buf.String() != expected {
+		t.Errorf("unexpected output:\ngot:  %q\nwant: %q", buf.String(), expected)
+	}
+}
+
+//nolint:gosmopolitan
+func TestPrintable_WriteUTF16_emoji(t *testing.T) {
+	buf := &bytes.Buffer{}
+	p := NewPrintable(buf, "utf-16", 16)
+	input1 := []byte{0xfe, 0xff, 0xd8, 0x3d, 0xdc}
+	input2 := []byte{0xa9, 0x30, 0x84, 0xd8, 0x3c, 0xdf, 0x7a, 0x30, 0x6a, 0x30, 0x69,
+		0x30, 0x6e, 0x7d, 0x75, 0x65, 0x87, 0x5b, 0x57}
+	_, err := p.Write(input1)
+	if err != nil {
+		t.Fatalf("Write error: %v", err)
+	}
+	_, err = p.Write(input2)
+	if err != nil {
+		t.Fatalf("Write error: %v", err)
+	}
+	if err = p.Close(); err != nil {
+		t.Error("close", "err", err)
+	}
+	expected := "BE💩__や🍺__など\nの絵文字\n"
+	if buf.String() != expected {
+		t.Errorf("unexpected output:\ngot:  %q\nwant: %q", buf.String(), expected)
+	}
+}
+
+//nolint:gosmopolitan
+func TestPrintable_WriteUTF16_hankaku(t *testing.T) {
+	buf := &bytes.Buffer{}
+	p := NewPrintable(buf, "utf16", 8)
+	input1 := []byte{
+		0xfe, 0xff, 0xff, 0x8a, 0xff, 0x9d, 0xff,
+	}
+	input2 := []byte{
+		0x76, 0xff, 0x78, 0xff, 0x76, 0xff, 0x85, 0xff, 0x93,
+		0xff, 0x7c, 0xff, 0x9e,
+	}
+	_, err := p.Write(input1)
+	if err != nil {
+		t.Fatalf("Write error: %v", err)
+	}
+	_, err = p.Write(input2)
+	if err != nil {
+		t.Fatalf("Write error: %v", err)
+	}
+	if err = p.Close(); err != nil {
+		t.Error("close", "err", err)
+	}
+	expected := "BEﾊ_ﾝ_ｶ_\nｸ_ｶ_ﾅ_ﾓ_\nｼ_ﾞ_\n"
+	if buf.String() != expected {
+		t.Errorf("unexpected output:\ngot:  %q\nwant: %q", buf.String(), expected)
+	}
+}
+
+//nolint:gosmopolitan
+func TestPrintable_WriteUTF32(t *testing.T) {
+	buf := &bytes.Buffer{}
+	p := NewPrintable(buf, "utf32", 8)
+	input1 := []byte{
+		0x00, 0x00, 0xfe, 0xff, 0x00, 0x00, 0x30, 0x53, 0x00,
+	}
+	input2 := []byte{
+		0x00, 0x30, 0x93, 0x00, 0x00, 0x30, 0x6b,
+		0x00, 0x00, 0x30, 0x61, 0x00, 0x00, 0x30, 0x6f, 0x00, 0x00, 0x4e, 0x16, 0x00, 0x00, 0x75, 0x4c,
+		0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x61, 0x00, 0x00, 0x00, 0x62, 0x00, 0x00, 0x00, 0x63,
+		0x00, 0x00, 0x00, 0x01, 0x00, 0x00, 0x00, 0x7f, 0x00, 0x00, 0x00, 0x21,
+	}
+	_, err := p.Write(input1)
+	if err != nil {
+		t.Fatalf("Write error: %v", err)
+	}
+	_, err = p.Write(input2)
+	if err != nil {
+		t.Fatalf("Write error: %v", err)
+	}
+	if err = p.Close(); err != nil {
+		t.Error("close", "err", err)
+	}
+	expected := "_BE_こ__\nん__に__\nち__は__\n世__界__\n.___a___\nb___c___\n.___.___\n!___\n"
+	if buf.String() != expected {
+		t.Errorf("unexpected output:\ngot:  %q\nwant: %q", buf.String(), expected)
+	}
+}
+
+//nolint:gosmopolitan
+func TestPrintable_WriteUTF32_emoji(t *testing.T) {
+	buf := &bytes.Buffer{}
+	p := NewPrintable(buf, "utf-32", 16)
+	input1 := []byte{
+		0x00, 0x00, 0xfe, 0xff, 0x00, 0x01, 0xf4, 0xa9, 0x00, 0x00, 0x30, 0x84, 0x00, 0x01, 0xf3,
+	}
+	input2 := []byte{
+		0x7a,
+		0x00, 0x00, 0x30, 0x6a, 0x00, 0x00, 0x30, 0x69, 0x00, 0x00, 0x30, 0x6e, 0x00, 0x00, 0x7d, 0x75,
+		0x00, 0x00, 0x65, 0x87, 0x00, 0x00, 0x5b, 0x57,
+	}
+	_, err := p.Write(input1)
+	if err != nil {
+		t.Fatalf("Write error: %v", err)
+	}
+	_, err = p.Write(input2)
+	if err != nil {
+		t.Fatalf("Write error: %v", err)
+	}
+	if err = p.Close(); err != nil {
+		t.Error("close", "err", err)
+	}
+	expected := "_BE_💩__や__🍺__\nな__ど__の__絵__\n文__字__\n"
+	if buf.String() != expected {
+		t.Errorf("unexpected output:\ngot:  %q\nwant: %q", buf.String(), expected)
+	}
+}
+
+//nolint:gosmopolitan
+func TestPrintable_WriteUTF32_hankaku(t *testing.T) {
+	buf := &bytes.Buffer{}
+	p := NewPrintable(buf, "utf32", 8)
+	input1 := []byte{
+		0x00, 0x00, 0xfe, 0xff, 0x00, 0x00, 0xff, 0x8a, 0x00, 0x00, 0xff, 0x9d, 0x00, 0x00, 0xff,
+	}
+	input2 := []byte{
+		0x76,
+		0x00, 0x00, 0xff, 0x78, 0x00, 0x00, 0xff, 0x76, 0x00, 0x00, 0xff, 0x85, 0x00, 0x00, 0xff, 0x93,
+		0x00, 0x00, 0xff, 0x7c, 0x00, 0x00, 0xff, 0x9e,
+	}
+	_, err := p.Write(input1)
+	if err != nil {
+		t.Fatalf("Write error: %v", err)
+	}
+	_, err = p.Write(input2)
+	if err != nil {
+		t.Fatalf("Write error: %v", err)
+	}
+	if err = p.Close(); err != nil {
+		t.Error("close", "err", err)
+	}
+	expected := "_BE_ﾊ___\nﾝ___ｶ___\nｸ___ｶ___\nﾅ___ﾓ___\nｼ___ﾞ___\n"
+	if buf.String() != expected {
+		t.Errorf("unexpected output:\ngot:  %q\nwant: %q", buf.String(), expected)
+	}
+}
+
+//nolint:gosmopolitan
+func TestPrintable_WriteEUCKR(t *testing.T) {
+	buf := &bytes.Buffer{}
+	p := NewPrintable(buf, "euc-kr", 8)
+	input := []byte{0xbe, 0xc8, 0xb3, 0xe7, 0xc8, 0xf7, 0xb0, 0xe8, 0xbd, 0xca, 0xbd, 0xc3, 0xbf, 0xc0}
+	_, err := p.Write(input)
+	if err != nil {
+		t.Fatalf("Write error: %v", err)
+	}
+	if err = p.Close(); err != nil {
+		t.Error("close", "err", err)
+	}
+	expected := "안녕히계\n십시오\n"
+	if buf.String() != expected {
+		t.Errorf("unexpected output:\ngot:  %q\nwant: %q", buf.String(), expected)
+	}
+}
+
+//nolint:gosmopolitan
+func TestPrintable_WriteEUCCN(t *testing.T) {
+	buf := &bytes.Buffer{}
+	p := NewPrintable(buf, "euc-cn", 8)
+	input1 := []byte{0xc4, 0xe3, 0xba}
+	input2 := []byte{0xc3, 0xce, 0xd2, 0xba, 0xc3}
+	_, err := p.Write(input1)
+	if err != nil {
+		t.Fatalf("Write error: %v", err)
+	}
+	_, err = p.Write(input2)
+	if err != nil {
+		t.Fatalf("Write error: %v", err)
+	}
+	if err = p.Close(); err != nil {
+		t.Error("close", "err", err)
+	}
+	expected := "你好我好\n"
+	if buf.String() != expected {
+		t.Errorf("unexpected output:\ngot:  %q\nwant: %q", buf.String(), expected)
+	}
+}
+
+//nolint:gosmopolitan
+func TestPrintable_WriteBig5(t *testing.T) {
+	buf := &bytes.Buffer{}
+	p := NewPrintable(buf, "big5", 8)
+	input1 := []byte{0xa7, 0x41, 0xa6}
+	input2 := []byte{0x6e, 0xa7, 0xda, 0xa6, 0x6e}
+	_, err := p.Write(input1)
+	if err != nil {
+		t.Fatalf("Write error: %v", err)
+	}
+	_, err = p.Write(input2)
+	if err != nil {
+		t.Fatalf("Write error: %v", err)
+	}
+	if err = p.Close(); err != nil {
+		t.Error("close", "err", err)
+	}
+	expected := "你好我好\n"
+	if buf.String() != expected {
+		t.Errorf("unexpected output:\ngot:  %q\nwant: %q", buf.String(), expected)
+	}
+}
+
+//nolint:gosmopolitan
+func TestPrintable_Write8859_1(t *testing.T) {
+	buf := &bytes.Buffer{}
+	p := NewPrintable(buf, "iso 8859-1", 8)
+	input := []byte{0x48, 0xe4, 0x6c, 0x6c, 0x6f, 0x20, 0x57, 0xf6, 0x72, 0x6c, 0x64}
+	_, err := p.Write(input)
+	if err != nil {
+		t.Fatalf("Write error: %v", err)
+	}
+	if err = p.Close(); err != nil {
+		t.Error("close", "err", err)
+	}
+	expected := "Hä_llo W\nö_rld\n"
+	if buf.String() != expected {
+		t.Errorf("unexpected output:\ngot:  %q\nwant: %q", buf.String(), expected)
+	}
+}
+
+//nolint:gosmopolitan
+func TestPrintable_WriteMultiLingual(t *testing.T) {
+	buf := &bytes.Buffer{}
+	p := NewPrintableSep(buf, "utf-8", 16, "|", "|")
+	// the Thai vowel signs above are in the cluster of their consonant
+	input := []byte("Привіт, світе\nสวัสดีชาวโลก\nhello, world\n")
+	_, err := p.Write(input)
+	if err != nil {
+		t.Fatalf("Write error: %v", err)
+	}
+	if err = p.Close(); err != nil {
+		t.Error("close", "err", err)
+	}
+	expected := "|П_р_и_в_і_т_, с_|\n|в_і_т_е_.ส__วั___|\n|__ส__ดี_____ช__า_|\n|_ว__โ__ล__ก__.he|\n|llo, world.\n"
+	if buf.String() != expected {
+		t.Errorf("unexpected output:\ngot:  %q\nwant: %q", buf.String(), expected)
+	}
+}
+
+func TestPrintable_Close(t *testing.T) {
+	buf := &bytes.Buffer{}
+	p := NewPrintable(buf, "utf-8", 8)
+	_, _ = p.Write([]byte("abc"))
+	if err := p.Close(); err != nil {
+		t.Errorf("Close error: %v", err)
+	}
+	expected := "abc\n"
+	if buf.String() != expected {
+		t.Errorf("unexpected output:\ngot:  %q\nwant: %q", buf.String(), expected)
+	}
+}
+
+func TestPrintable_Close_wrap(t *testing.T) {
+	buf := &bytes.Buffer{}
+	p := NewPrintableSep(buf, "utf-8", 4, "|", "|")
+	_, _ = p.Write([]byte("abc\xe3\x81"))
+	if err := p.Close(); err != nil {
+		t.Errorf("Close error: %v", err)
+	}
+	expected := "|abc.|\n|.\n"
+	if buf.String() != expected {
+		t.Errorf("unexpected output:\ngot:  %q\nwant: %q", buf.String(), expected)
+	}
+}
+
+func TestPrintable_WriteUTF32_invalid(t *testing.T) {
+	buf := &bytes.Buffer{}
+	p := NewPrintable(buf, "utf-32be", 8)
+	_, _ = p.Write([]byte{0x00, 0x11, 0x00, 0x00, 0x00, 0x00, 0x00, 0x41})
+	if err := p.Close(); err != nil {
+		t.Errorf("Close error: %v", err)
+	}
+	expected := "...___..\n"
+	if buf.String() != expected {
+		t.Errorf("unexpected output:\ngot:  %q\nwant: %q", buf.String(), expected)
+	}
+}
+
+func TestPrintable_SetOffset(t *testing.T) {
+	buf := &bytes.Buffer{}
+	p := NewPrintableSep(buf, "utf-8", 8, "|", "|")
+	p.SetOffset(0x1f005)
+	_, _ = p.Write([]byte("hello"))
+	if err := p.Close(); err != nil {
+		t.Errorf("Close error: %v", err)
+	}
+	expected := "|     hel|\n|lo\n"
+	if buf.String() != expected {
+		t.Errorf("unexpected output:\ngot:  %q\nwant: %q", buf.String(), expected)
+	}
+}
+
+//nolint:gosmopolitan
+func TestPrintable_WriteShiftJIS_split(t *testing.T) {
+	buf := &bytes.Buffer{}
+	p := NewPrintableSep(buf, "shift-jis", 4, "|", "|")
+	input1 := []byte{0x82, 0xb1, 0x82}
+	input2 := []byte{0xf1, 0x82}
+	input3 := []byte{0xc9, 0x82, 0xbf}
+	for _, input := range [][]byte{input1, input2, input3} {
+		n, err := p.Write(input)
+		if err != nil {
+			t.Fatalf("Write error: %v", err)
+		}
+		if n != len(input) {
+			t.Errorf("short write: %d, expected %d", n, len(input))
+		}
+	}
+	if err := p.Close(); err != nil {
+		t.Error("close", "err", err)
+	}
+	expected := "|こん|\n|にち|\n"
+	if buf.String() != expected {
+		t.Errorf("unexpected output:\ngot:  %q\nwant: %q", buf.String(), expected)
+	}
+}
+
+//nolint:gosmopolitan
+func TestPrintable_WriteISO2022JP(t *testing.T) {
+	buf := &bytes.Buffer{}
+	p := NewPrintableSep(buf, "iso-2022-jp", 8, "|", "|")
+	// the state and the escape sequences continue across writes and rows
+	inputs := []string{"a\x1b$", "B$3$s\x1b(I", "C=D", "\x1b(J\\\x1b", "(B\x01"}
+	for _, input := range inputs {
+		if _, err := p.Write([]byte(input)); err != nil {
+			t.Fatalf("Write error: %v", err)
+		}
+	}
+	if err := p.Close(); err != nil {
+		t.Error("close", "err", err)
+	}
+	expected := "|a^$Bこん|\n|^(Iﾃｽﾄ^(|\n|J¥^(B.\n"
+	if buf.String() != expected {
+		t.Errorf("unexpected output:\ngot:  %q\nwant: %q", buf.String(), expected)
+	}
+}
+
+//nolint:gosmopolitan
+func TestPrintable_WriteISO2022KR(t *testing.T) {
+	buf := &bytes.Buffer{}
+	p := NewPrintable(buf, "iso-2022-kr", 8)
+	// the line feed shifts back to ascii
+	input := []byte("\x1b$)C\x0e>H3g\x0f!\x0e>H\n>H")
+	if _, err := p.Write(input); err != nil {
+		t.Fatalf("Write error: %v", err)
+	}
+	if err := p.Close(); err != nil {
+		t.Error("close", "err", err)
+	}
+	expected := "^$)C{안녕\n_}!{안.>\nH\n"
+	if buf.String() != expected {
+		t.Errorf("unexpected output:\ngot:  %q\nwant: %q", buf.String(), expected)
+	}
+}
+
+//nolint:gosmopolitan
+func TestPrintable_WriteISO2022CN(t *testing.T) {
+	buf := &bytes.Buffer{}
+	p := NewPrintable(buf, "iso-2022-cn", 8)
+	// the designation ends with the line, and CNS 11643 is not decoded
+	input := []byte("\x1b$)A\x0eDc:C\x0f\n\x0eDc\x0f\x1b$*H\x1bND!")
+	if _, err := p.Write(input); err != nil {
+		t.Fatalf("Write error: %v", err)
+	}
+	if err := p.Close(); err != nil {
+		t.Error("close", "err", err)
+	}
+	expected := "^$)A{你好\n_}.{..}^\n$*H^N..\n"
+	if buf.String() != expected {
+		t.Errorf("unexpected output:\ngot:  %q\nwant: %q", buf.String(), expected)
+	}
+}
+
+//nolint:gosmopolitan
+func TestPrintable_WriteGB18030(t *testing.T) {
+	buf := &bytes.Buffer{}
+	p := NewPrintable(buf, "gb18030", 8)
+	// GBK "丂亐", four-byte "ä😀" and a control character in four bytes
+	input1 := []byte{0x81, 0x40, 0x81, 0x80, 0x81, 0x30}
+	input2 := []byte{0x8a, 0x31, 0x94, 0x39, 0xfc, 0x36, 0x81, 0x30, 0x81, 0x30, 0x80, 0x61}
+	for _, input := range [][]byte{input1, input2} {
+		if _, err := p.Write(input); err != nil {
+			t.Fatalf("Write error: %v", err)
+		}
+	}
+	if err := p.Close(); err != nil {
+		t.Error("close", "err", err)
+	}
+	expected := "丂亐ä___\n😀__....\n.a\n"
+	if buf.String() != expected {
+		t.Errorf("unexpected output:\ngot:  %q\nwant: %q", buf.String(), expected)
+	}
+}
+
+//nolint:gosmopolitan
+func TestPrintable_WriteEUCJP_0212(t *testing.T) {
+	buf := &bytes.Buffer{}
+	p := NewPrintable(buf, "euc-jp", 8)
+	// JIS X 0212 "丂" split across writes, half-width kana and an invalid SS3
+	input1 := []byte{0x61, 0x8f, 0xb0}
+	input2 := []byte{0xa1, 0x8e, 0xca, 0xb4, 0xc1, 0x8f, 0xb0, 0x41, 0x8f, 0xb0, 0xa1}
+	for _, input := range [][]byte{input1, input2} {
+		if _, err := p.Write(input); err != nil {
+			t.Fatalf("Write error: %v", err)
+		}
+	}
+	if err := p.Close(); err != nil {
+		t.Error("close", "err", err)
+	}
+	expected := "a丂_ﾊ_漢\n..A丂_\n"
+	if buf.String() != expected {
+		t.Errorf("unexpected output:\ngot:  %q\nwant: %q", buf.String(), expected)
+	}
+}
+
+//nolint:gosmopolitan
+func TestPrintable_WriteEUCJIS2004(t *testing.T) {
+	buf := &bytes.Buffer{}
+	p := NewPrintable(buf, "euc-jis-2004", 8)
+	// plane 2 "𠂉", the combining "か゚", plane 1 "俱" and JIS X 0208 "漢"
+	input := []byte{0x8f, 0xa1, 0xa1, 0xa4, 0xf7, 0xae, 0xa1, 0xb4, 0xc1, 0x8f, 0xb0, 0xa1}
+	if _, err := p.Write(input); err != nil {
+		t.Fatalf("Write error: %v", err)
+	}
+	if err := p.Close(); err != nil {
+		t.Error("close", "err", err)
+	}
+	expected := "𠂉_か゚俱漢\n_...\n"
+	if buf.String() != expected {
+		t.Errorf("unexpected output:\ngot:  %q\nwant: %q", buf.String(), expected)
+	}
+}
+
+//nolint:gosmopolitan
+func TestPrintable_WriteShiftJIS_variants(t *testing.T) {
+	// NEC "①", IBM "ⅰ", wave dash, user-defined, "亜" and user-defined again;
+	// JIS X 0213 has "豗" and "𠂉" of plane 2 in place of the IBM and
+	// user-defined ones
+	input := []byte{0x87, 0x40, 0xfa, 0x40, 0x81, 0x60, 0xf0, 0x40, 0x88, 0x9f, 0xf0, 0x40}
+	tests := []struct {
+		encoding string
+		expected string
+	}{
+		{"shift-jis", "....〜.\n_亜..\n"},
+		{"cp932", "①_ⅰ_～.\n_亜..\n"},
+		{"shift_jis-2004", "①_豗〜𠂉\n_亜𠂉\n"},
+	}
+	for _, tt := range tests {
+		buf := &bytes.Buffer{}
+		p := NewPrintable(buf, tt.encoding, 7)
+		if _, err := p.Write(input); err != nil {
+			t.Fatalf("Write error: %v", err)
+		}
+		if err := p.Close(); err != nil {
+			t.Error("close", "err", err)
+		}
+		if buf.String() != tt.expected {
+			t.Errorf("unexpected output of %s:\ngot:  %q\nwant: %q", tt.encoding, buf.String(), tt.expected)
+		}
+	}
+}
+
+//nolint:gosmopolitan
+func TestPrintable_WriteWindowsDBCS(t *testing.T) {
+	// GBK "丂", UHC "갂", HKSCS "䏰", "一", 0xF9FE and the user-defined 0xC6A1 of CP950
+	input := []byte("a\x80\x81\x40\xb0\xa1 \x81\x41\xb0\xa1 \x87\x40\xa4\x40\xf9\xfe\xc6\xa1")
+	tests := []struct {
+		encoding string
+		expected string
+	}{
+		{"cp936", "a€丂啊 丄啊 嘆....啤\n"},
+		{"cp949", "a..@가 갂가 .@.@航퉤\n"},
+		{"cp950", "a...陛 ..陛 ..一▓_..\n"},
+		{"big5-hkscs", "a...陛 ..陛 䏰一￭_①_\n"},
+	}
+	for _, tt := range tests {
+		buf := &bytes.Buffer{}
+		p := NewPrintable(buf, tt.encoding, 20)
+		if _, err := p.Write(input); err != nil {
+			t.Fatalf("Write error: %v", err)
+		}
+		if err := p.Close(); err != nil {
+			t.Error("close", "err", err)
+		}
+		if buf.String() != tt.expected {
+			t.Errorf("unexpected output of %s:\ngot:  %q\nwant: %q", tt.encoding, buf.String(), tt.expected)
+		}
+	}
+}
+
+//nolint:gosmopolitan
+func TestPrintable_WriteEBCDIC(t *testing.T) {
+	buf := &bytes.Buffer{}
+	p := NewPrintable(buf, "ibm939", 8)
+	// "か" crosses the row, and a lead byte before SI is invalid
+	input := []byte("\xc1\x82\x0e\x4f\x58\x48\xf2\x44\x86\x0f\xc1\x0e\x45\x0f\x15")
+	if _, err := p.Write(input); err != nil {
+		t.Fatalf("Write error: %v", err)
+	}
+	if err := p.Close(); err != nil {
+		t.Error("close", "err", err)
+	}
+	expected := "Ab{漢字か\n_}A{.}.\n"
+	if buf.String() != expected {
+		t.Errorf("unexpected output:\ngot:  %q\nwant: %q", buf.String(), expected)
+	}
+}
+
+//nolint:gosmopolitan
+func TestPrintable_WriteEBCDIC_variants(t *testing.T) {
+	tests := []struct {
+		encoding string
+		input    string
+		expected string
+	}{
+		{"ibm930", "\xc1\x81\x0e\x44\x86\x0f", "Aｱ{か}\n"},
+		{"cp1390", "\xc1\x81\x0e\xec\xb5\x0f\xe1", "Aｱ{か゚}€\n"},
+		{"ibm-933", "\xc1\x82\x0e\xd0\x65\x8a\x82\x0f", "Ab{한국}\n"},
+		{"ibm935", "\xc1\x82\x0e\x5b\xcf\x57\xc3\x0f", "Ab{中文}\n"},
+		{"ibm937", "\xc1\x82\x0e\x4c\x84\x4c\xc5\x0f", "Ab{中文}\n"},
+	}
+	for _, tt := range tests {
+		buf := &bytes.Buffer{}
+		p := NewPrintable(buf, tt.encoding, 16)
+		if _, err := p.Write([]byte(tt.input)); err != nil {
+			t.Fatalf("Write error: %v", err)
+		}
+		if err := p.Close(); err != nil {
+			t.Error("close", "err", err)
+		}
+		if buf.String() != tt.expected {
+			t.Errorf("unexpected output of %s:\ngot:  %q\nwant: %q", tt.encoding, buf.String(), tt.expected)
+		}
+	}
+}
+
+//nolint:gosmopolitan
+func TestPrintable_WriteUTF8_variants(t *testing.T) {
+	// NUL of Modified UTF-8, a surrogate pair, an unpaired surrogate and a
+	// four-byte character
+	input := []byte("a\xc0\x80\xed\xa0\xbd\xed\xb8\x80\xed\xa0\x80b\xf0\x9f\x98\x80")
+	tests := []struct {
+		encoding string
+		expected string
+	}{
+		{"utf-8", "a...........b😀__\n"},
+		{"cesu-8", "a..😀____...b....\n"},
+		{"mutf-8", "a._😀____...b....\n"},
+		{"wtf-8", "a..�__�__�__b😀__\n"},
+	}
+	for _, tt := range tests {
+		buf := &bytes.Buffer{}
+		p := NewPrintable(buf, tt.encoding, 20)
+		// one byte at a time, to split the sequences
+		for idx := range input {
+			if _, err := p.Write(input[idx : idx+1]); err != nil {
+				t.Fatalf("Write error: %v", err)
+			}
+		}
+		if err := p.Close(); err != nil {
+			t.Error("close", "err", err)
+		}
+		if buf.String() != tt.expected {
+			t.Errorf("unexpected output of %s:\ngot:  %q\nwant: %q", tt.encoding, buf.String(), tt.expected)
+		}
+	}
+}
+
+//nolint:gosmopolitan
+func TestPrintable_WriteUTF7(t *testing.T) {
+	buf := &bytes.Buffer{}
+	p := NewPrintable(buf, "utf-7", 16)
+	// "-" ends a section or is literal, "." ends it and stays, "+-" is "+"
+	input := []byte("-+Jjo--A+ImIDkQ. +ZeVnLIqe- +- +2D3eAA-")
+	for idx := range input {
+		if _, err := p.Write(input[idx : idx+1]); err != nil {
+			t.Fatalf("Write error: %v", err)
+		}
+	}
+	if err := p.Close(); err != nil {
+		t.Error("close", "err", err)
+	}
+	expected := "-+☺__--A+≢__Α__.\n +日_本_語- +_ +\n😀____-\n"
+	if buf.String() != expected {
+		t.Errorf("unexpected output:\ngot:  %q\nwant: %q", buf.String(), expected)
+	}
+}
+
+//nolint:gosmopolitan
+func TestPrintable_WriteUTF8_graphemes(t *testing.T) {
+	// a combining accent, a ZWJ family, a flag, a heart with the emoji
+	// variation selector and conjoining jamo
+	input := []byte("e\u0301 \U0001F468\u200d\U0001F469\u200d\U0001F467 \U0001F1EF\U0001F1F5 \u2764\ufe0f \u1100\u1161\u11a8\r\n")
+	tests := []struct {
+		components bool
+		expected   string
+	}{
+		{false, "e\u0301__ 👨\u200d👩\u200d👧________________ 🇯🇵______ \n\u2764\ufe0f____ \u1100\u1161\u11a8_______..\n"},
+		{true, "e◌\u0301_ 👨__...👩__...👧__ 🇯___🇵___ \n\u2764__◌\ufe0f__ \u1100_\u1161__\u11a8__..\n"},
+	}
+	for _, tt := range tests {
+		buf := &bytes.Buffer{}
+		p := NewPrintable(buf, "utf-8", 32)
+		p.components = tt.components
+		// one byte at a time, so that the clusters wait for their end
+		for idx := range input {
+			if _, err := p.Write(input[idx : idx+1]); err != nil {
+				t.Fatalf("Write error: %v", err)
+			}
+		}
+		if err := p.Close(); err != nil {
+			t.Error("close", "err", err)
+		}
+		if buf.String() != tt.expected {
+			t.Errorf("unexpected output of components=%v:\ngot:  %q\nwant: %q", tt.components, buf.String(), tt.expected)
+		}
+	}
+}
+
+func TestPrintable_WriteUTF8_long_cluster(t *testing.T) {
+	buf := &bytes.Buffer{}
+	p := NewPrintable(buf, "utf-8", 8)
+	// 21 bytes of one cluster pad the rest of its row and the rows after it
+	marks := strings.Repeat("\u0301", 10)
+	if _, err := p.Write([]byte("a" + marks + "b")); err != nil {
+		t.Fatalf("Write error: %v", err)
+	}
+	if err := p.Close(); err != nil {
+		t.Error("close", "err", err)
+	}
+	expected := "a" + marks + "_______\n________\n_____b\n"
+	if buf.String() != expected {
+		t.Errorf("unexpected output:\ngot:  %q\nwant: %q", buf.String(), expected)
+	}
+}
+
+func TestPrintable_WriteUTF8_capped_cluster(t *testing.T) {
+	// up to 30 marks make one cluster, and the code points of a longer one
+	// are shown one by one
+	tests := []struct {
+		marks    int
+		expected string
+	}{
+		{30, "a" + strings.Repeat("\u0301", 30) + strings.Repeat("_", 60) + "b\n"},
+		{31, "a" + strings.Repeat("\u25cc\u0301_", 31) + "b\n"},
+	}
+	for _, tt := range tests {
+		buf := &bytes.Buffer{}
+		p := NewPrintable(buf, "utf-8", 64)
+		if _, err := p.Write([]byte("a" + strings.Repeat("\u0301", tt.marks) + "b")); err != nil {
+			t.Fatalf("Write error: %v", err)
+		}
+		if err := p.Close(); err != nil {
+			t.Error("close", "err", err)
+		}
+		if buf.String() != tt.expected {
+			t.Errorf("unexpected output of %d marks:\ngot:  %q\nwant: %q", tt.marks, buf.String(), tt.expected)
+		}
+	}
+}
+
+//nolint:gosmopolitan
+func TestPrintable_control_styles(t *testing.T) {
+	// the expected output in the order of control_styles: dots, pictures,
+	// caret and names
+	tests := []struct {
+		encoding string
+		input    []byte
+		expected [4]string
+	}{
+		{"ascii", []byte("a\r\n\x00\x7f\x80"), [4]string{"a.....\n", "a␍␊␀␡.\n", "a^M^J^@^?.\n", "aCRLFNULDEL.\n"}},
+		// CR LF is one grapheme cluster, and U+0085 is not a C0 control
+		{"utf-8", []byte("a\r\n\x00\x7f\xc2\x85"), [4]string{"a......\n", "a␍␊␀␡..\n", "a^M^J^@^?..\n", "aCRLFNULDEL..\n"}},
+		{"utf-16le", []byte("a\x00\n\x00\x1b\x00"), [4]string{"a_....\n", "a_␊_␛_\n", "a_^J^[\n", "a_LFESC\n"}},
+		{"utf-32be", []byte("\x00\x00\x00\t\x00\x00\x00a"), [4]string{".___a___\n", "␉___a___\n", "^I__a___\n", "HT__a___\n"}},
+		{"sjis", []byte("\x82\xa0\t\x07\x7f"), [4]string{"あ...\n", "あ␉␇␡\n", "あ^I^G^?\n", "あHTBELDEL\n"}},
+		{"cp932", []byte("\x7f\xb1"), [4]string{".ｱ\n", "␡ｱ\n", "^?ｱ\n", "DELｱ\n"}},
+		{"euc-kr", []byte("\xb0\xa1\x1b\x08"), [4]string{"가..\n", "가␛␈\n", "가^[^H\n", "가ESCBS\n"}},
+		{"iso-2022-jp", []byte("a\r\n\x1b$B$\"\x1b(B"), [4]string{"a..^$Bあ^(B\n", "a␍␊^$Bあ^(B\n", "a^M^J^$Bあ^(B\n", "aCRLF^$Bあ^(B\n"}},
+		// LF, HT and DEL of EBCDIC
+		{"ibm930", []byte("\xc1\x25\x05\x07"), [4]string{"A...\n", "A␊␉␡\n", "A^J^I^?\n", "ALFHTDEL\n"}},
+		{"ISO 8859-1", []byte("a\x00\x1f"), [4]string{"a..\n", "a␀␟\n", "a^@^_\n", "aNULUS\n"}},
+		{"utf-7", []byte("+AAo-\x01"), [4]string{"+...-.\n", "+␊__-␁\n", "+^J_-^A\n", "+LF_-SOH\n"}},
+		{"mutf-8", []byte("\xc0\x80\x01"), [4]string{"._.\n", "␀_␁\n", "^@^A\n", "NULSOH\n"}},
+	}
+	for _, tt := range tests {
+		for idx, style := range control_styles {
+			buf := &bytes.Buffer{}
+			p := NewPrintable(buf, tt.encoding, 16)
+			p.control_style = style
+			if _, err := p.Write(tt.input); err != nil {
+				t.Fatalf("Write error: %v", err)
+			}
+			if err := p.Close(); err != nil {
+				t.Error("close", "err", err)
+			}
+			if buf.String() != tt.expected[idx] {
+				t.Errorf("unexpected output of %s in %s:\ngot:  %q\nwant: %q", tt.encoding, style, buf.String(), tt.expected[idx])
+			}
+		}
+	}
+}
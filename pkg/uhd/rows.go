@@ -0,0 +1,122 @@
+package uhd
+
+import (
+	"bytes"
+	"io"
+	"strings"
+)
+
+// row_data is one row of the input with the text of each layout column. The
+// printable columns also have the characters traced from their text.
+type row_data struct {
+	offset uint64
+	data   []byte
+	texts  []string
+	chars  [][]trace_item
+}
+
+// row_splitter runs the column writers of a layout and calls emit for each
+// complete row. It is the base of the structured output formats.
+type row_splitter struct {
+	layout  *Layout
+	writers []io.Writer
+	outputs []*bytes.Buffer
+	traces  []*tracer
+	start   uint64
+	data    []byte
+	emit    func(row row_data) error
+}
+
+// line takes the next line written by column idx.
+func (s *row_splitter) line(idx int) string {
+	line, _ := s.outputs[idx].ReadString('\n')
+	return strings.TrimSuffix(line, "\n")
+}
+
+// flush emits the complete rows. Unless final, a row waits for some bytes of
+// the next one so that a character crossing the row end is decoded.
+func (s *row_splitter) flush(final bool) error {
+	width := uint64(s.layout.width)
+	for len(s.data) != 0 {
+		row_end := (s.start/width + 1) * width
+		n := row_end - s.start
+		if !final && uint64(len(s.data)) < n+8 {
+			break
+		}
+		n = min(n, uint64(len(s.data)))
+		row := row_data{
+			offset: s.start,
+			data:   s.data[:n],
+			texts:  make([]string, len(s.layout.columns)),
+			chars:  make([][]trace_item, len(s.layout.columns)),
+		}
+		for idx := range s.layout.columns {
+			row.texts[idx] = s.line(idx)
+			if t := s.traces[idx]; t != nil {
+				count := 0
+				for count < len(t.items) && t.items[count].Offset < row_end {
+					count++
+				}
+				row.chars[idx] = t.items[:count:count]
+				t.items = t.items[count:]
+			}
+		}
+		if err := s.emit(row); err != nil {
+			return err
+		}
+		s.start += n
+		s.data = s.data[n:]
+	}
+	return nil
+}
+
+func (s *row_splitter) Write(p []byte) (n int, err error) {
+	s.data = append(s.data, p...)
+	for _, wr := range s.writers {
+		if _, err := wr.Write(p); err != nil {
+			return 0, err
+		}
+	}
+	return len(p), s.flush(false)
+}
+
+func (s *row_splitter) SetOffset(offset uint64) {
+	s.start = offset
+	for _, wr := range s.writers {
+		if ofs, ok := wr.(offsetter); ok {
+			ofs.SetOffset(offset)
+		}
+	}
+}
+
+// Close flushes the column writers and emits the remaining rows.
+func (s *row_splitter) Close() error {
+	for _, wr := range s.writers {
+		if closer, ok := wr.(io.Closer); ok {
+			if err := closer.Close(); err != nil {
+				return err
+			}
+		}
+	}
+	return s.flush(true)
+}
+
+func newRowSplitter(layout *Layout, emit func(row row_data) error) *row_splitter {
+	s := &row_splitter{
+		layout: layout,
+		emit:   emit,
+	}
+	for _, col := range layout.columns {
+		buf := &bytes.Buffer{}
+		wr := layout.new_writer(col, buf)
+		var t *tracer
+		if p, ok := wr.(*Printable); ok {
+			t = &tracer{width: layout.width}
+			p.trace = t
+		}
+		s.writers = append(s.writers, wr)
+		s.outputs = append(s.outputs, buf)
+		s.traces = append(s.traces, t)
+	}
+	return s
+}
@@ -0,0 +1,197 @@
+package uhd
+
+import (
+	"fmt"
+	"io"
+	"path/filepath"
+	"slices"
+	"strings"
+)
+
+// source_language is how a source output declares a byte array: head and
+// tail go around the rows, with %s for the name, and length declares the
+// length constant, with %s for the name and %d for the length. The comments
+// of the rows are aligned unless the formatter of the language does not.
+// reserved are the words that cannot be the name, which the upper case names
+// of rust never are.
+type source_language struct {
+	head     string
+	indent   string
+	comment  func(s string) string
+	aligned  bool
+	tail     string
+	length   string
+	upper    bool
+	reserved []string
+}
+
+var source_languages = map[string]source_language{
+	"c": {
+		head:    "unsigned char %s[] = {\n",
+		indent:  "  ",
+		comment: func(s string) string { return "/* " + strings.ReplaceAll(s, "*/", "* /") + " */" },
+		aligned: true,
+		tail:    "};\n",
+		length:  "unsigned int %s_len = %d;\n",
+		reserved: []string{
+			"alignas", "alignof", "auto", "bool", "break", "case", "char", "const", "constexpr",
+			"continue", "default", "do", "double", "else", "enum", "extern", "false", "float", "for",
+			"goto", "if", "inline", "int", "long", "nullptr", "register", "restrict", "return", "short",
+			"signed", "sizeof", "static", "static_assert", "struct", "switch", "thread_local", "true",
+			"typedef", "typeof", "typeof_unqual", "union", "unsigned", "void", "volatile", "while",
+		},
+	},
+	"go": {
+		head:    "var %s = []byte{\n",
+		indent:  "\t",
+		comment: func(s string) string { return "// " + s },
+		tail:    "}\n",
+		length:  "\nconst %s_len = %d\n",
+		reserved: []string{
+			"break", "case", "chan", "const", "continue", "default", "defer", "else", "fallthrough",
+			"for", "func", "go", "goto", "if", "import", "init", "interface", "map", "package", "range",
+			"return", "select", "struct", "switch", "type", "var",
+		},
+	},
+	"rust": {
+		head:    "pub const %s: &[u8] = &[\n",
+		indent:  "    ",
+		comment: func(s string) string { return "// " + s },
+		aligned: true,
+		tail:    "];\n",
+		length:  "pub const %s_LEN: usize = %d;\n",
+		upper:   true,
+	},
+	"python": {
+		head:    "%s = bytes([\n",
+		indent:  "    ",
+		comment: func(s string) string { return "# " + s },
+		aligned: true,
+		tail:    "])\n",
+		length:  "%s_len = %d\n",
+		reserved: []string{
+			"False", "None", "True", "and", "as", "assert", "async", "await", "break", "class",
+			"continue", "def", "del", "elif", "else", "except", "finally", "for", "from", "global",
+			"if", "import", "in", "is", "lambda", "nonlocal", "not", "or", "pass", "raise", "return",
+			"try", "while", "with", "yield",
+		},
+	},
+	"js": {
+		head:    "const %s = new Uint8Array([\n",
+		indent:  "  ",
+		comment: func(s string) string { return "// " + s },
+		aligned: true,
+		tail:    "]);\n",
+		length:  "const %s_len = %d;\n",
+		reserved: []string{
+			"arguments", "await", "break", "case", "catch", "class", "const", "continue", "debugger",
+			"default", "delete", "do", "else", "enum", "eval", "export", "extends", "false", "finally",
+			"for", "function", "if", "implements", "import", "in", "instanceof", "interface", "let",
+			"new", "null", "package", "private", "protected", "public", "return", "static", "super",
+			"switch", "this", "throw", "true", "try", "typeof", "var", "void", "while", "with", "yield",
+		},
+	},
+}
+
+// source_name derives an identifier from a file name as xxd -i does: the
+// characters that cannot be in a name become "_".
+func source_name(filename string) string {
+	name := strings.Map(func(r rune) rune {
+		if 'a' <= r && r <= 'z' || 'A' <= r && r <= 'Z' || '0' <= r && r <= '9' || r == '_' {
+			return r
+		}
+		return '_'
+	}, filepath.Base(filename))
+	name = strings.Trim(name, "_")
+	switch {
+	case name == "" || name == ".":
+		return "data"
+	case '0' <= name[0] && name[0] <= '9':
+		return "_" + name
+	}
+	return name
+}
+
+// sourcewriter writes the bytes as an array declaration of a programming
+// language, like xxd -i. Each row ends in a comment with the text of the
+// first printable column of the layout, if it has one.
+type sourcewriter struct {
+	*row_splitter
+	output io.Writer
+	lang   source_language
+	name   string
+	column int
+	length uint64
+	count  int
+}
+
+func (s *sourcewriter) emit(row row_data) error {
+	if s.count == 0 {
+		if _, err := fmt.Fprintf(s.output, s.lang.head, s.name); err != nil {
+			return err
+		}
+	}
+	s.count++
+	s.length += uint64(len(row.data))
+	var sb strings.Builder
+	sb.WriteString(s.lang.indent)
+	for idx, b := range row.data {
+		if idx != 0 {
+			sb.WriteString(" ")
+		}
+		fmt.Fprintf(&sb, "0x%02x,", b)
+	}
+	if s.column >= 0 {
+		if s.lang.aligned {
+			// keep the comments of a short row in line with the full rows
+			sb.WriteString(strings.Repeat(" ", 6*(s.layout.width-len(row.data))+1))
+		}
+		txt := strings.TrimRight(ansiEscape.ReplaceAllString(row.texts[s.column], ""), " ")
+		sb.WriteString(" " + s.lang.comment(txt))
+	}
+	_, err := fmt.Fprintln(s.output, sb.String())
+	return err
+}
+
+func (s *sourcewriter) Close() error {
+	if err := s.row_splitter.Close(); err != nil {
+		return err
+	}
+	if s.count == 0 {
+		if _, err := fmt.Fprintf(s.output, s.lang.head, s.name); err != nil {
+			return err
+		}
+	}
+	_, err := fmt.Fprintf(s.output, s.lang.tail+s.lang.length, s.name, s.length)
+	return err
+}
+
+// newSourceWriter creates a sourcewriter for layout in lang ("c", "go",
+// "rust", "python" or "js"). The name of the array comes from filename, with
+// a "_" in front if it is a reserved word of lang.
+func newSourceWriter(output io.Writer, layout *Layout, lang, filename string) (*sourcewriter, error) {
+	l, ok := source_languages[lang]
+	if !ok {
+		return nil, fmt.Errorf("unknown language: %q", lang)
+	}
+	s := &sourcewriter{
+		output: output,
+		lang:   l,
+		name:   source_name(filename),
+		column: -1,
+	}
+	if l.upper {
+		s.name = strings.ToUpper(s.name)
+	}
+	if slices.Contains(l.reserved, s.name) {
+		s.name = "_" + s.name
+	}
+	for idx, col := range layout.columns {
+		if col.name == "printable" {
+			s.column = idx
+			break
+		}
+	}
+	s.row_splitter = newRowSplitter(layout, s.emit)
+	return s, nil
+}
@@ -0,0 +1,131 @@
+package uhd
+
+import (
+	"bytes"
+	"testing"
+)
+
+//nolint:gosmopolitan
+func TestSourceName(t *testing.T) {
+	for name, expected := range map[string]string{
+		"hello.txt":      "hello_txt",
+		"dir/fw-1.2.bin": "fw_1_2_bin",
+		"(stdin)":        "stdin",
+		"1st.bin":        "_1st_bin",
+		"":               "data",
+		"/tmp/_x_":       "x",
+		"/tmp/データ.bin":   "bin",
+	} {
+		if got := source_name(name); got != expected {
+			t.Errorf("unexpected name of %q:\ngot:  %q\nwant: %q", name, got, expected)
+		}
+	}
+}
+
+func TestSourceWriter(t *testing.T) {
+	tests := []struct {
+		lang     string
+		layout   string
+		expected string
+	}{
+		{"c", "bytes", "unsigned char a_bin[] = {\n" +
+			"  0x61, 0x2a, 0x2f, 0x00,  /* a* /. */\n" +
+			"  0x62,                    /* b */\n" +
+			"};\n" +
+			"unsigned int a_bin_len = 5;\n"},
+		{"go", "bytes", "var a_bin = []byte{\n" +
+			"\t0x61, 0x2a, 0x2f, 0x00, // a*/.\n" +
+			"\t0x62, // b\n" +
+			"}\n\n" +
+			"const a_bin_len = 5\n"},
+		{"rust", "hexbytes", "pub const A_BIN: &[u8] = &[\n" +
+			"    0x61, 0x2a, 0x2f, 0x00,\n" +
+			"    0x62,\n" +
+			"];\n" +
+			"pub const A_BIN_LEN: usize = 5;\n"},
+		{"python", "printable", "a_bin = bytes([\n" +
+			"    0x61, 0x2a, 0x2f, 0x00,  # a*/.\n" +
+			"    0x62,                    # b\n" +
+			"])\n" +
+			"a_bin_len = 5\n"},
+		{"js", "bytes", "const a_bin = new Uint8Array([\n" +
+			"  0x61, 0x2a, 0x2f, 0x00,  // a*/.\n" +
+			"  0x62,                    // b\n" +
+			"]);\n" +
+			"const a_bin_len = 5;\n"},
+	}
+	for _, tt := range tests {
+		layout, err := ParseLayout(tt.layout, "utf-8", 4, 8)
+		if err != nil {
+			t.Fatal("ParseLayout", "err", err)
+		}
+		buf := &bytes.Buffer{}
+		sw, err := newSourceWriter(buf, layout, tt.lang, "data/a.bin")
+		if err != nil {
+			t.Fatal("newSourceWriter", "err", err)
+		}
+		if _, err := sw.Write([]byte("a*/\x00b")); err != nil {
+			t.Fatal("write", "err", err)
+		}
+		if err := sw.Close(); err != nil {
+			t.Fatal("close", "err", err)
+		}
+		if buf.String() != tt.expected {
+			t.Errorf("unexpected output of %s:\ngot:  %q\nwant: %q", tt.lang, buf.String(), tt.expected)
+		}
+	}
+}
+
+func TestSourceWriter_Empty(t *testing.T) {
+	layout, err := ParseLayout("bytes", "utf-8", 16, 8)
+	if err != nil {
+		t.Fatal("ParseLayout", "err", err)
+	}
+	buf := &bytes.Buffer{}
+	sw, err := newSourceWriter(buf, layout, "go", "empty")
+	if err != nil {
+		t.Fatal("newSourceWriter", "err", err)
+	}
+	if err := sw.Close(); err != nil {
+		t.Fatal("close", "err", err)
+	}
+	expected := "var empty = []byte{\n}\n\nconst empty_len = 0\n"
+	if buf.String() != expected {
+		t.Errorf("unexpected output:\ngot:  %q\nwant: %q", buf.String(), expected)
+	}
+	if _, err := newSourceWriter(buf, layout, "cobol", "x"); err == nil {
+		t.Error("no error for an unknown language")
+	}
+}
+
+func TestSourceWriter_Reserved(t *testing.T) {
+	tests := []struct {
+		lang     string
+		filename string
+		expected string
+	}{
+		{"js", "/dev/null", "const _null = new Uint8Array([\n]);\nconst _null_len = 0;\n"},
+		{"c", "/dev/null", "unsigned char null[] = {\n};\nunsigned int null_len = 0;\n"},
+		{"go", "default", "var _default = []byte{\n}\n\nconst _default_len = 0\n"},
+		{"python", "/tmp/class", "_class = bytes([\n])\n_class_len = 0\n"},
+		{"c", "int", "unsigned char _int[] = {\n};\nunsigned int _int_len = 0;\n"},
+		{"rust", "/dev/null", "pub const NULL: &[u8] = &[\n];\npub const NULL_LEN: usize = 0;\n"},
+	}
+	layout, err := ParseLayout("bytes", "utf-8", 16, 8)
+	if err != nil {
+		t.Fatal("ParseLayout", "err", err)
+	}
+	for _, tt := range tests {
+		buf := &bytes.Buffer{}
+		sw, err := newSourceWriter(buf, layout, tt.lang, tt.filename)
+		if err != nil {
+			t.Fatal("newSourceWriter", "err", err)
+		}
+		if err := sw.Close(); err != nil {
+			t.Fatal("close", "err", err)
+		}
+		if buf.String() != tt.expected {
+			t.Errorf("unexpected output of %s %s:\ngot:  %q\nwant: %q", tt.lang, tt.filename, buf.String(), tt.expected)
+		}
+	}
+}
@@ -0,0 +1,301 @@
+package uhd
+
+import (
+	"bytes"
+	"fmt"
+	"strings"
+	"unicode"
+	"unicode/utf16"
+	"unicode/utf8"
+
+	"github.com/fatih/color"
+)
+
+// utf8_variant returns "cesu-8", "mutf-8" or "wtf-8" for the name of a UTF-8
+// variant, or "" for other encodings.
+func utf8_variant(name string) string {
+	switch strings.ToLower(name) {
+	case "cesu-8", "cesu8":
+		return "cesu-8"
+	case "mutf-8", "mutf8", "java-modified-utf-8":
+		return "mutf-8"
+	case "wtf-8", "wtf8":
+		return "wtf-8"
+	}
+	return ""
+}
+
+// encode_utf8_variant encodes text in a UTF-8 variant: cesu-8 and mutf-8
+// write the code points past U+FFFF as the three-byte forms of their UTF-16
+// surrogates, and mutf-8 writes U+0000 as C0 80.
+func encode_utf8_variant(text, variant string) []byte {
+	res := make([]byte, 0, len(text))
+	for _, r := range text {
+		switch {
+		case variant == "mutf-8" && r == 0:
+			res = append(res, 0xc0, 0x80)
+		case variant != "wtf-8" && r > 0xffff:
+			hi, lo := utf16.EncodeRune(r)
+			for _, s := range []rune{hi, lo} {
+				res = append(res, 0xe0|byte(s>>12), 0x80|byte(s>>6)&0x3f, 0x80|byte(s)&0x3f)
+			}
+		default:
+			res = utf8.AppendRune(res, r)
+		}
+	}
+	return res
+}
+
+// surrogate decodes the three-byte form of a UTF-16 surrogate at the top of
+// p. It returns 0 if p does not start with one and -1 if p ends in the middle
+// of it.
+func surrogate(p []byte) (rune, int) {
+	if p[0] != 0xed {
+		return 0, 0
+	}
+	if len(p) >= 2 && !in_range(p[1], 0xa0, 0xbf) || len(p) >= 3 && !in_range(p[2], 0x80, 0xbf) {
+		return 0, 0
+	}
+	if len(p) < 3 {
+		return 0, -1
+	}
+	return 0xd000 | rune(p[1]&0x3f)<<6 | rune(p[2]&0x3f), 3
+}
+
+// utf8_variant_next decodes the character at the top of p in variant. size
+// is 0 if p does not start with a valid character and -1 if p ends in the
+// middle of it. unusual is set for the forms that standard UTF-8 does not
+// allow: surrogates and the two-byte NUL of Modified UTF-8.
+func utf8_variant_next(p []byte, variant string) (r rune, size int, unusual bool) {
+	if variant == "mutf-8" && p[0] == 0xc0 {
+		if len(p) < 2 {
+			return 0, -1, false
+		}
+		if p[1] == 0x80 {
+			return 0, 2, true
+		}
+		return 0, 0, false
+	}
+	if hi, n := surrogate(p); n != 0 {
+		if n < 0 {
+			return 0, -1, false
+		}
+		if variant == "wtf-8" {
+			// a pair is written in four bytes, so this one is unpaired
+			return hi, 3, true
+		}
+		if !utf16.IsSurrogate(hi) || hi >= 0xdc00 {
+			return 0, 0, false
+		}
+		if len(p) == 3 {
+			return 0, -1, false
+		}
+		lo, n := surrogate(p[3:])
+		if n < 0 {
+			return 0, -1, false
+		}
+		if n == 0 || lo < 0xdc00 {
+			return 0, 0, false
+		}
+		return utf16.DecodeRune(hi, lo), 6, true
+	}
+	if !utf8.FullRune(p) {
+		return 0, -1, false
+	}
+	r, size = utf8.DecodeRune(p)
+	if r == utf8.RuneError && size == 1 {
+		return r, 0, false
+	}
+	if size == 4 && variant != "wtf-8" {
+		// CESU-8 and Modified UTF-8 write these as a surrogate pair
+		return r, 0, false
+	}
+	return r, size, false
+}
+
+// writeUTF8Variant shows CESU-8, Modified UTF-8 or WTF-8. The forms that
+// standard UTF-8 does not allow are shown in yellow: an unpaired surrogate as
+// U+FFFD and the NUL of Modified UTF-8 as "." or in the control style.
+func (h *Printable) writeUTF8Variant(p []byte, variant string) (n int, err error) {
+	buf := append(h.rest, p...)
+	for len(buf) > 0 {
+		r, size, unusual := utf8_variant_next(buf, variant)
+		if size == -1 {
+			break
+		}
+		if h.cur%uint64(h.width) == 0 {
+			h.text(h.start_ch)
+		}
+		switch {
+		case size == 0:
+			size = 1
+			h.put("", 1)
+		case unusual && r == 0:
+			text := control_text(h.control_style, 0)
+			if text == "" {
+				text = "."
+			}
+			cells := utf8.RuneCountInString(text)
+			h.place(text, size, cells, func(s string) {
+				h.trace.add("variant", s, min(cells, size))
+				fmt.Fprint(h.output, color.YellowString(s))
+			})
+		case unusual && utf16.IsSurrogate(r):
+			h.put_as(string(unicode.ReplacementChar), size, h.variant)
+		case !unicode.IsPrint(r):
+			h.put_control(r, size)
+		case unusual:
+			h.put_as(string(r), size, h.variant)
+		default:
+			h.put(string(r), size)
+		}
+		buf = buf[size:]
+	}
+	h.rest = bytes.Clone(buf)
+	return len(p), nil
+}
+
+// utf7_name tells if name is UTF-7.
+func utf7_name(name string) bool {
+	return strings.EqualFold(name, "utf-7") || strings.EqualFold(name, "utf7")
+}
+
+const utf7_base64 = "ABCDEFGHIJKLMNOPQRSTUVWXYZabcdefghijklmnopqrstuvwxyz0123456789+/"
+
+// utf7 is the state of a UTF-7 decoder: in a base64 section after "+", the
+// bits left over from the last UTF-16 code unit.
+type utf7 struct {
+	base64 bool
+	bits   uint32
+	nbits  int
+}
+
+// utf7_resync returns the position of the last byte in p that is not a base64
+// character, after which UTF-7 is out of any base64 section, or 0 if there is
+// none.
+func utf7_resync(p []byte) int {
+	res := 0
+	for idx, ch := range p {
+		if strings.IndexByte(utf7_base64, ch) < 0 {
+			res = idx
+		}
+	}
+	return res
+}
+
+// utf7_next shows the shift or character at the top of p and returns its
+// length, or 0 if p ends in the middle of it.
+func (h *Printable) utf7_next(p []byte) int {
+	st := h.utf7
+	if !st.base64 {
+		if p[0] == '+' {
+			if len(p) < 2 {
+				return 0
+			}
+			if h.cur%uint64(h.width) == 0 {
+				h.text(h.start_ch)
+			}
+			if p[1] == '-' {
+				h.put("+", 2)
+				return 2
+			}
+			st.base64, st.bits, st.nbits = true, 0, 0
+			h.marker("+")
+			return 1
+		}
+		if h.cur%uint64(h.width) == 0 {
+			h.text(h.start_ch)
+		}
+		if in_range(p[0], 0x20, 0x7e) {
+			h.put(string(p[0]), 1)
+		} else {
+			h.put_control(rune(p[0]), 1)
+		}
+		return 1
+	}
+	// collect the code units of one character
+	bits, nbits := st.bits, st.nbits
+	var units []uint16
+	size := 0
+	for size < len(p) && len(units) < 2 {
+		val := strings.IndexByte(utf7_base64, p[size])
+		if val < 0 {
+			break
+		}
+		bits = bits<<6 | uint32(val)
+		nbits += 6
+		size++
+		if nbits >= 16 {
+			nbits -= 16
+			units = append(units, uint16(bits>>nbits))
+			bits &= 1<<nbits - 1
+			if !utf16.IsSurrogate(rune(units[0])) || units[0] >= 0xdc00 {
+				break
+			}
+		}
+	}
+	if size == len(p) && !(len(units) == 2 || len(units) == 1 && !utf16.IsSurrogate(rune(units[0]))) {
+		return 0
+	}
+	if len(units) == 0 && size == 0 {
+		st.base64 = false
+		if p[0] != '-' {
+			return h.utf7_next(p)
+		}
+		if h.cur%uint64(h.width) == 0 {
+			h.text(h.start_ch)
+		}
+		h.marker("-")
+		return 1
+	}
+	if len(units) == 0 {
+		// the padding bits after the last character of the section
+		for range size {
+			if h.cur%uint64(h.width) == 0 {
+				h.text(h.start_ch)
+			}
+			if bits == 0 {
+				h.pad2(1)
+			} else {
+				h.pad1(1)
+			}
+			h.cur++
+			if h.cur%uint64(h.width) == 0 {
+				h.text(h.end_ch + "\n")
+			}
+		}
+		return size
+	}
+	if h.cur%uint64(h.width) == 0 {
+		h.text(h.start_ch)
+	}
+	st.bits, st.nbits = bits, nbits
+	r := rune(units[0])
+	if len(units) == 2 {
+		r = utf16.DecodeRune(r, rune(units[1]))
+	}
+	if utf16.IsSurrogate(r) || r == unicode.ReplacementChar || !unicode.IsPrint(r) {
+		h.put_control(r, size)
+	} else {
+		h.put_as(string(r), size, h.variant)
+	}
+	return size
+}
+
+// writeUTF7 shows UTF-7: "+" and "-" around a base64 section are shown as
+// markers, and the characters of the section in yellow.
+func (h *Printable) writeUTF7(p []byte) (n int, err error) {
+	if h.utf7 == nil {
+		h.utf7 = &utf7{}
+	}
+	buf := append(h.rest, p...)
+	for len(buf) > 0 {
+		size := h.utf7_next(buf)
+		if size == 0 {
+			break
+		}
+		buf = buf[size:]
+	}
+	h.rest = bytes.Clone(buf)
+	return len(p), nil
+}
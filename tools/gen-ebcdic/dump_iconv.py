@@ -0,0 +1,62 @@
+"""Dump an IBM host code page of glibc iconv as a mapping to Unicode in the
+format of euc-jis-2004-std.txt of x0213.org. The single bytes are dumped as
+they are, the double bytes between SO and SI.
+
+    python3 tools/gen-ebcdic/dump_iconv.py IBM930 > pkg/uhd/testdata/ibm930.txt
+"""
+
+import ctypes
+import ctypes.util
+import sys
+
+libc = ctypes.CDLL(ctypes.util.find_library("c"))
+libc.iconv_open.restype = ctypes.c_void_p
+libc.iconv_open.argtypes = [ctypes.c_char_p, ctypes.c_char_p]
+libc.iconv.restype = ctypes.c_size_t
+libc.iconv.argtypes = [ctypes.c_void_p] + [ctypes.c_void_p] * 4
+libc.gnu_get_libc_version.restype = ctypes.c_char_p
+
+SO, SI = 0x0E, 0x0F
+
+
+def decode(cd, seq):
+    """Return the text of seq, or None if iconv refuses it."""
+    libc.iconv(cd, None, None, None, None)
+    inbuf = ctypes.create_string_buffer(seq, len(seq))
+    outbuf = ctypes.create_string_buffer(64)
+    inptr = ctypes.c_char_p(ctypes.addressof(inbuf))
+    outptr = ctypes.c_char_p(ctypes.addressof(outbuf))
+    inleft = ctypes.c_size_t(len(seq))
+    outleft = ctypes.c_size_t(len(outbuf))
+    res = libc.iconv(cd, ctypes.byref(inptr), ctypes.byref(inleft), ctypes.byref(outptr), ctypes.byref(outleft))
+    if res == ctypes.c_size_t(-1).value or inleft.value:
+        return None
+    return outbuf.raw[: len(outbuf) - outleft.value].decode("utf-8")
+
+
+def line(code, text):
+    return "0x%02X\tU+%s" % (code, "+".join("%04X" % ord(ch) for ch in text))
+
+
+def main():
+    name = sys.argv[1]
+    cd = libc.iconv_open(b"UTF-8", name.encode())
+    if cd == ctypes.c_void_p(-1).value:
+        sys.exit("unknown code page: %s" % name)
+    print("# %s to Unicode from iconv of glibc %s" % (name, libc.gnu_get_libc_version().decode()))
+    print("# generated by tools/gen-ebcdic/dump_iconv.py")
+    for b in range(0x100):
+        if b in (SO, SI):
+            continue
+        text = decode(cd, bytes([b]))
+        if text:
+            print(line(b, text))
+    for b1 in range(0x40, 0x100):
+        for b2 in range(0x40, 0x100):
+            text = decode(cd, bytes([SO, b1, b2, SI]))
+            if text:
+                print(line(b1 << 8 | b2, text))
+
+
+if __name__ == "__main__":
+    main()
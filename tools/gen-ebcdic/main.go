@@ -0,0 +1,237 @@
+// gen-ebcdic generates the EBCDIC tables of pkg/uhd from mappings of the IBM
+// host code pages to Unicode in the format of euc-jis-2004-std.txt of
+// x0213.org: "0x4541<TAB>U+4E00", one code per line, the double bytes as they
+// are between SO and SI, "#" starts a comment. go generate runs it in pkg/uhd:
+//
+//	go run ../../tools/gen-ebcdic testdata ebcdic_tables.go
+//
+// The mappings in testdata, ibm930.txt and so on, are dumped from glibc iconv
+// by dump_iconv.py. Each double-byte character set is taken from the first of
+// its code pages, and the others are checked against it.
+package main
+
+import (
+	"bufio"
+	"bytes"
+	"fmt"
+	"go/format"
+	"log"
+	"maps"
+	"os"
+	"path/filepath"
+	"slices"
+	"strconv"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+)
+
+// codepages are the code pages of pkg/uhd in the order of the tables.
+var codepages = []string{"930", "939", "1390", "1399", "933", "935", "937"}
+
+// charset is a double-byte character set taken from the first of the code
+// pages from. A charset with a base only holds what it adds to the base.
+type charset struct {
+	name string
+	from []string
+	base string
+}
+
+// charsets are the double-byte character sets of pkg/uhd in the order of the
+// tables.
+var charsets = []charset{
+	{"300", []string{"930", "939"}, ""},
+	{"16684", []string{"1390", "1399"}, "300"},
+	{"834", []string{"933"}, ""},
+	{"837", []string{"935"}, ""},
+	{"835", []string{"937"}, ""},
+}
+
+type mapping struct {
+	sbcs map[byte]string
+	dbcs map[uint16]string
+}
+
+type tables struct {
+	sbcs  map[string]*[256]rune
+	dbcs  map[string]map[byte]*[191]rune
+	pairs map[uint16]string
+}
+
+// parse_line parses "0x4541<TAB>U+4E00" into the code and the string.
+func parse_line(line string) (uint32, string, error) {
+	line, _, _ = strings.Cut(line, "#")
+	fields := strings.Fields(line)
+	if len(fields) == 0 {
+		return 0, "", nil
+	}
+	if len(fields) != 2 || !strings.HasPrefix(fields[1], "U+") {
+		return 0, "", fmt.Errorf("invalid line: %q", line)
+	}
+	code, err := strconv.ParseUint(strings.TrimPrefix(fields[0], "0x"), 16, 32)
+	if err != nil {
+		return 0, "", fmt.Errorf("invalid code: %q", fields[0])
+	}
+	var sb strings.Builder
+	for _, cp := range strings.Split(fields[1][2:], "+") {
+		r, err := strconv.ParseUint(cp, 16, 32)
+		if err != nil {
+			return 0, "", fmt.Errorf("invalid code point: %q", cp)
+		}
+		sb.WriteRune(rune(r))
+	}
+	return uint32(code), sb.String(), nil
+}
+
+func read_mapping(name string) (mapping, error) {
+	res := mapping{sbcs: map[byte]string{}, dbcs: map[uint16]string{}}
+	fp, err := os.Open(name)
+	if err != nil {
+		return res, err
+	}
+	defer fp.Close()
+	scanner := bufio.NewScanner(fp)
+	for scanner.Scan() {
+		code, s, err := parse_line(scanner.Text())
+		switch {
+		case err != nil:
+			return res, fmt.Errorf("%s: %w", name, err)
+		case s == "":
+			continue
+		case code <= 0xff:
+			res.sbcs[byte(code)] = s
+		case code <= 0xffff && code>>8 >= 0x40 && code&0xff >= 0x40:
+			res.dbcs[uint16(code)] = s
+		default:
+			return res, fmt.Errorf("%s: invalid code: %X", name, code)
+		}
+	}
+	return res, scanner.Err()
+}
+
+// graphic returns the character of s, or U+FFFD if it is a control, a
+// private use character or more than one character.
+func graphic(s string) rune {
+	r, size := utf8.DecodeRuneInString(s)
+	if size != len(s) || unicode.IsControl(r) || unicode.Is(unicode.Co, r) {
+		return unicode.ReplacementChar
+	}
+	return r
+}
+
+func build(mappings map[string]mapping) (tables, error) {
+	res := tables{
+		sbcs:  map[string]*[256]rune{},
+		dbcs:  map[string]map[byte]*[191]rune{},
+		pairs: map[uint16]string{},
+	}
+	for _, cp := range codepages {
+		row := &[256]rune{}
+		for b := range row {
+			row[b] = unicode.ReplacementChar
+			if s, ok := mappings[cp].sbcs[byte(b)]; ok {
+				row[b] = graphic(s)
+			}
+		}
+		res.sbcs[cp] = row
+	}
+	for _, set := range charsets {
+		cells := mappings[set.from[0]].dbcs
+		for _, cp := range set.from[1:] {
+			if !maps.Equal(cells, mappings[cp].dbcs) {
+				return res, fmt.Errorf("the double bytes of %s and %s differ", set.from[0], cp)
+			}
+		}
+		var base map[uint16]string
+		if idx := slices.IndexFunc(charsets, func(c charset) bool { return c.name == set.base }); idx >= 0 {
+			base = mappings[charsets[idx].from[0]].dbcs
+		}
+		rows := map[byte]*[191]rune{}
+		for code, s := range cells {
+			if base != nil && base[code] == s {
+				continue
+			}
+			if base != nil && utf8.RuneCountInString(s) == 2 {
+				res.pairs[code] = s
+				continue
+			}
+			r := graphic(s)
+			if r == unicode.ReplacementChar {
+				continue
+			}
+			lead := byte(code >> 8)
+			if rows[lead] == nil {
+				rows[lead] = &[191]rune{}
+				for idx := range rows[lead] {
+					rows[lead][idx] = unicode.ReplacementChar
+				}
+			}
+			rows[lead][byte(code)-0x40] = r
+		}
+		res.dbcs[set.name] = rows
+	}
+	return res, nil
+}
+
+func generate(t tables, source string) ([]byte, error) {
+	buf := &bytes.Buffer{}
+	fmt.Fprintf(buf, "// Code generated by gen-ebcdic from %s; DO NOT EDIT.\n\n", source)
+	buf.WriteString("package uhd\n\n")
+	buf.WriteString("// ebcdic_sbcs holds the single-byte characters of each code page by byte.\n")
+	buf.WriteString("// U+FFFD is a control or an unassigned byte.\n")
+	buf.WriteString("var ebcdic_sbcs = map[string]string{\n")
+	for _, cp := range codepages {
+		fmt.Fprintf(buf, "\t%q: %s,\n", cp, strconv.Quote(string(t.sbcs[cp][:])))
+	}
+	buf.WriteString("}\n\n")
+	buf.WriteString("// ebcdic_dbcs holds the double-byte characters of each character set by lead\n")
+	buf.WriteString("// byte, one for each trail byte from 0x40 to 0xFE. U+FFFD is an unassigned or\n")
+	buf.WriteString("// user-defined cell. \"16684\" only holds what CCSID 1390/1399 add to \"300\".\n")
+	buf.WriteString("var ebcdic_dbcs = map[string]map[byte]string{\n")
+	for _, set := range charsets {
+		fmt.Fprintf(buf, "\t%q: {\n", set.name)
+		rows := t.dbcs[set.name]
+		for _, lead := range slices.Sorted(maps.Keys(rows)) {
+			fmt.Fprintf(buf, "\t\t%#02x: %s,\n", lead, strconv.Quote(string(rows[lead][:])))
+		}
+		buf.WriteString("\t},\n")
+	}
+	buf.WriteString("}\n\n")
+	buf.WriteString("// ebcdic_pairs holds the characters of CCSID 16684 made of a base and a\n")
+	buf.WriteString("// combining character.\n")
+	buf.WriteString("var ebcdic_pairs = map[uint16]string{\n")
+	for _, code := range slices.Sorted(maps.Keys(t.pairs)) {
+		fmt.Fprintf(buf, "\t%#04x: \"", code)
+		for _, r := range t.pairs[code] {
+			fmt.Fprintf(buf, "\\u%04x", r)
+		}
+		buf.WriteString("\",\n")
+	}
+	buf.WriteString("}\n")
+	return format.Source(buf.Bytes())
+}
+
+func main() {
+	if len(os.Args) != 3 {
+		log.Fatal("usage: gen-ebcdic DIR OUTPUT")
+	}
+	mappings := map[string]mapping{}
+	for _, cp := range codepages {
+		m, err := read_mapping(filepath.Join(os.Args[1], "ibm"+cp+".txt"))
+		if err != nil {
+			log.Fatal(err)
+		}
+		mappings[cp] = m
+	}
+	t, err := build(mappings)
+	if err != nil {
+		log.Fatal(err)
+	}
+	src, err := generate(t, filepath.Join(os.Args[1], "ibm*.txt"))
+	if err != nil {
+		log.Fatal(err)
+	}
+	if err := os.WriteFile(os.Args[2], src, 0o644); err != nil {
+		log.Fatal(err)
+	}
+}
@@ -0,0 +1,28 @@
+#!/bin/sh
+# Fetch the files of the Unicode Character Database that gen-grapheme reads.
+# SOURCE is the ucd directory of a release, as a URL or a local copy:
+#
+#   tools/gen-grapheme/fetch_ucd.sh https://www.unicode.org/Public/17.0.0/ucd pkg/uhd/testdata/ucd
+#
+# DerivedCoreProperties.txt is cut down to its header and Indic_Conjunct_Break.
+set -eu
+src=$1
+dst=$2
+
+get() {
+	case "$src" in
+	http://* | https://*) curl -fsSL "$src/$1" ;;
+	*) cat "$src/$1" ;;
+	esac
+}
+
+mkdir -p "$dst/auxiliary" "$dst/emoji"
+get auxiliary/GraphemeBreakProperty.txt >"$dst/auxiliary/GraphemeBreakProperty.txt"
+get auxiliary/GraphemeBreakTest.txt >"$dst/auxiliary/GraphemeBreakTest.txt"
+get emoji/emoji-data.txt >"$dst/emoji/emoji-data.txt"
+get DerivedCoreProperties.txt | awk '
+	BEGIN { head = 1 }
+	/^# =+$/ { head = 0 }
+	/^# Derived Property: / { keep = /Indic_Conjunct_Break/ }
+	head || keep
+' >"$dst/DerivedCoreProperties.txt"
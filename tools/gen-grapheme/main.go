@@ -0,0 +1,195 @@
+// gen-grapheme generates the grapheme cluster tables of pkg/uhd from the
+// files of the Unicode Character Database: Grapheme_Cluster_Break, the
+// Extended_Pictographic of emoji-data.txt and Indic_Conjunct_Break. go
+// generate runs it in pkg/uhd:
+//
+//	go run ../../tools/gen-grapheme testdata/ucd grapheme_tables.go
+//
+// The files in testdata are fetched by fetch_ucd.sh. They must be of the
+// Unicode version of the Go toolchain, so that the clusters agree with the
+// unicode package.
+package main
+
+import (
+	"bufio"
+	"bytes"
+	"cmp"
+	"fmt"
+	"go/format"
+	"log"
+	"os"
+	"path/filepath"
+	"regexp"
+	"slices"
+	"strconv"
+	"strings"
+	"unicode"
+)
+
+// gcb_names are the names of the Grapheme_Cluster_Break values in pkg/uhd.
+var gcb_names = map[string]string{
+	"Prepend":            "gcb_prepend",
+	"CR":                 "gcb_cr",
+	"LF":                 "gcb_lf",
+	"Control":            "gcb_control",
+	"Extend":             "gcb_extend",
+	"Regional_Indicator": "gcb_regional_indicator",
+	"SpacingMark":        "gcb_spacingmark",
+	"L":                  "gcb_l",
+	"V":                  "gcb_v",
+	"T":                  "gcb_t",
+	"LV":                 "gcb_lv",
+	"LVT":                "gcb_lvt",
+	"ZWJ":                "gcb_zwj",
+}
+
+// incb_names are the names of the Indic_Conjunct_Break values in pkg/uhd.
+var incb_names = map[string]string{
+	"Consonant": "incb_consonant",
+	"Extend":    "incb_extend",
+	"Linker":    "incb_linker",
+}
+
+// version_line matches the version in the header of a file of the UCD, as
+// "# GraphemeBreakProperty-17.0.0.txt" or "# Version: 17.0".
+var version_line = regexp.MustCompile(`^# (?:\S+-(\d+\.\d+\.\d+)\.txt|Version: (\d+\.\d+))$`)
+
+type prop_range struct {
+	start rune
+	end   rune
+	value string
+}
+
+// read_property reads the ranges of a file of the UCD and names their values
+// by values. With a property, only its lines are read, and the value is the
+// field after the property name, or the name for a binary property. It also
+// returns the Unicode version of the header.
+func read_property(name string, property string, values map[string]string) ([]prop_range, string, error) {
+	fp, err := os.Open(name)
+	if err != nil {
+		return nil, "", err
+	}
+	defer fp.Close()
+	res := []prop_range{}
+	version := ""
+	scanner := bufio.NewScanner(fp)
+	for scanner.Scan() {
+		line := scanner.Text()
+		if m := version_line.FindStringSubmatch(line); m != nil && version == "" {
+			version = m[1] + m[2]
+		}
+		line, _, _ = strings.Cut(line, "#")
+		fields := strings.Split(line, ";")
+		for idx := range fields {
+			fields[idx] = strings.TrimSpace(fields[idx])
+		}
+		if len(fields) < 2 {
+			continue
+		}
+		value := fields[1]
+		if property != "" {
+			if value != property {
+				continue
+			}
+			if len(fields) > 2 {
+				value = fields[2]
+			}
+		}
+		ident, ok := values[value]
+		if !ok {
+			return nil, "", fmt.Errorf("%s: unknown value: %q", filepath.Base(name), value)
+		}
+		first, last, _ := strings.Cut(fields[0], "..")
+		if last == "" {
+			last = first
+		}
+		start, err1 := strconv.ParseUint(first, 16, 32)
+		end, err2 := strconv.ParseUint(last, 16, 32)
+		if err1 != nil || err2 != nil {
+			return nil, "", fmt.Errorf("%s: invalid range: %q", filepath.Base(name), fields[0])
+		}
+		res = append(res, prop_range{rune(start), rune(end), ident})
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, "", err
+	}
+	if version == "" {
+		return nil, "", fmt.Errorf("%s: no version", name)
+	}
+	return merge(res), version, nil
+}
+
+// merge sorts the ranges and joins the adjacent ones of the same value.
+func merge(ranges []prop_range) []prop_range {
+	slices.SortFunc(ranges, func(a, b prop_range) int { return cmp.Compare(a.start, b.start) })
+	res := []prop_range{}
+	for _, r := range ranges {
+		if n := len(res); n > 0 && res[n-1].end+1 == r.start && res[n-1].value == r.value {
+			res[n-1].end = r.end
+			continue
+		}
+		res = append(res, r)
+	}
+	return res
+}
+
+func write_ranges(buf *bytes.Buffer, ranges []prop_range) {
+	for _, r := range ranges {
+		if r.value == "" {
+			fmt.Fprintf(buf, "\t{0x%04x, 0x%04x},\n", r.start, r.end)
+		} else {
+			fmt.Fprintf(buf, "\t{0x%04x, 0x%04x, %s},\n", r.start, r.end, r.value)
+		}
+	}
+}
+
+func main() {
+	if len(os.Args) != 3 {
+		log.Fatal("usage: gen-grapheme UCD OUTPUT")
+	}
+	dir := os.Args[1]
+	breaks, v1, err := read_property(filepath.Join(dir, "auxiliary", "GraphemeBreakProperty.txt"), "", gcb_names)
+	if err != nil {
+		log.Fatal(err)
+	}
+	pictographic, v2, err := read_property(filepath.Join(dir, "emoji", "emoji-data.txt"), "Extended_Pictographic", map[string]string{"Extended_Pictographic": ""})
+	if err != nil {
+		log.Fatal(err)
+	}
+	conjunct, v3, err := read_property(filepath.Join(dir, "DerivedCoreProperties.txt"), "InCB", incb_names)
+	if err != nil {
+		log.Fatal(err)
+	}
+	for _, v := range []string{v2, v3} {
+		if !strings.HasPrefix(v1+".", v+".") {
+			log.Fatalf("the files are of Unicode %s and %s", v1, v)
+		}
+	}
+	if v1 != unicode.Version {
+		log.Fatalf("the files are of Unicode %s, but the toolchain is of %s", v1, unicode.Version)
+	}
+	buf := &bytes.Buffer{}
+	fmt.Fprintf(buf, "// Code generated by gen-grapheme from the Unicode %s files of %s; DO NOT EDIT.\n\n", v1, dir)
+	buf.WriteString("package uhd\n\n")
+	buf.WriteString("// grapheme_breaks holds the Grapheme_Cluster_Break property of UAX #29 by\n")
+	buf.WriteString("// range of code points. Code points not listed are gcb_other.\n")
+	buf.WriteString("var grapheme_breaks = []grapheme_range{\n")
+	write_ranges(buf, breaks)
+	buf.WriteString("}\n\n")
+	buf.WriteString("// extended_pictographic holds the Extended_Pictographic code points of UTS #51.\n")
+	buf.WriteString("var extended_pictographic = []uintrange{\n")
+	write_ranges(buf, pictographic)
+	buf.WriteString("}\n\n")
+	buf.WriteString("// indic_conjunct_breaks holds the Indic_Conjunct_Break property of UAX #44 by\n")
+	buf.WriteString("// range of code points. Code points not listed are incb_none.\n")
+	buf.WriteString("var indic_conjunct_breaks = []incb_range{\n")
+	write_ranges(buf, conjunct)
+	buf.WriteString("}\n")
+	src, err := format.Source(buf.Bytes())
+	if err != nil {
+		log.Fatal(err)
+	}
+	if err := os.WriteFile(os.Args[2], src, 0o644); err != nil {
+		log.Fatal(err)
+	}
+}
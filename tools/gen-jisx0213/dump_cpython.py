@@ -0,0 +1,38 @@
+"""Dump the euc_jis_2004 codec of CPython as a mapping of EUC-JIS-2004 to
+Unicode in the format of euc-jis-2004-std.txt of x0213.org.
+
+    python3 tools/gen-jisx0213/dump_cpython.py > pkg/uhd/testdata/euc-jis-2004.txt
+"""
+
+import platform
+
+
+def line(code, seq):
+    try:
+        text = seq.decode("euc_jis_2004")
+    except UnicodeDecodeError:
+        return None
+    return "0x%X\tU+%s" % (code, "+".join("%04X" % ord(ch) for ch in text))
+
+
+def main():
+    print("# EUC-JIS-2004 to Unicode from the euc_jis_2004 codec of CPython %s" % platform.python_version())
+    print("# generated by tools/gen-jisx0213/dump_cpython.py")
+    for b1 in range(0xA1, 0xFF):
+        for b2 in range(0xA1, 0xFF):
+            res = line(b1 << 8 | b2, bytes([b1, b2]))
+            if res:
+                print(res)
+    for b2 in range(0xA1, 0xE0):
+        res = line(0x8E << 8 | b2, bytes([0x8E, b2]))
+        if res:
+            print(res)
+    for b1 in range(0xA1, 0xFF):
+        for b2 in range(0xA1, 0xFF):
+            res = line(0x8F << 16 | b1 << 8 | b2, bytes([0x8F, b1, b2]))
+            if res:
+                print(res)
+
+
+if __name__ == "__main__":
+    main()
@@ -0,0 +1,228 @@
+// gen-jisx0213 generates the JIS X 0213 tables of pkg/uhd from a mapping of
+// EUC-JIS-2004 to Unicode in the format of euc-jis-2004-std.txt of
+// x0213.org: "0xA4F7<TAB>U+304B+309A", one code per line, "#" starts a
+// comment. go generate runs it in pkg/uhd:
+//
+//	go run ../../tools/gen-jisx0213 testdata/euc-jis-2004.txt jisx0213.go
+//
+// The mapping in testdata is dumped from CPython by dump_cpython.py. The
+// cells of plane 1 that x/text decodes as JIS X 0208 to the same character
+// and the rows of SS3 outside plane 2 are left out.
+package main
+
+import (
+	"bufio"
+	"bytes"
+	"fmt"
+	"go/format"
+	"log"
+	"os"
+	"slices"
+	"strconv"
+	"strings"
+	"unicode/utf8"
+
+	"golang.org/x/text/encoding/japanese"
+)
+
+// jisx0208_chars maps the characters where the tables of x/text follow
+// Microsoft to the ones of JIS X 0208, as in pkg/uhd.
+var jisx0208_chars = map[string]string{
+	"～": "〜",
+	"∥": "‖",
+	"－": "−",
+	"￠": "¢",
+	"￡": "£",
+	"￢": "¬",
+}
+
+// plane2_rows are the rows of JIS X 0213 plane 2. The other rows of SS3 are
+// JIS X 0212, which some decoders fall back to.
+var plane2_rows = []byte{1, 3, 4, 5, 8, 12, 13, 14, 15, 78, 79, 80, 81, 82, 83, 84, 85, 86, 87, 88, 89, 90, 91, 92, 93, 94}
+
+// jisx0208_unassigned are the EUC-JP codes of the cells that JIS X 0208
+// leaves empty, as valid_eucjp of pkg/uhd.
+var jisx0208_unassigned = [][2]uint32{
+	{0xa2af, 0xa2b9}, {0xa2c2, 0xa2c9}, {0xa2d1, 0xa2db}, {0xa2eb, 0xa2f1}, {0xa2fa, 0xa2fd},
+	{0xa3a1, 0xa3af}, {0xa3ba, 0xa3c0}, {0xa3db, 0xa3e0}, {0xa3fb, 0xa3fe},
+	{0xa4f4, 0xa4fe}, {0xa5f7, 0xa5fe}, {0xa6b9, 0xa6c0}, {0xa6d9, 0xa6fe},
+	{0xa7c2, 0xa7d0}, {0xa7f2, 0xa7fe}, {0xa8c1, 0xa8fe},
+	{0xa9a1, 0xaffe}, {0xf4a7, 0xfefe},
+}
+
+type tables struct {
+	plane1 map[byte]*[94]rune
+	plane2 map[byte]*[94]rune
+	pairs  map[uint16]string
+}
+
+// jisx0208 returns the character of a two-byte EUC-JP code as x/text
+// decodes it, or "" if it is not a cell of JIS X 0208.
+func jisx0208(code uint32) string {
+	for _, r := range jisx0208_unassigned {
+		if r[0] <= code && code <= r[1] {
+			return ""
+		}
+	}
+	res, err := japanese.EUCJP.NewDecoder().Bytes([]byte{byte(code >> 8), byte(code)})
+	if err != nil || !utf8.Valid(res) || bytes.ContainsRune(res, utf8.RuneError) {
+		return ""
+	}
+	if s, ok := jisx0208_chars[string(res)]; ok {
+		return s
+	}
+	return string(res)
+}
+
+// parse_line parses "0xA4F7<TAB>U+304B+309A" into the code and the string.
+func parse_line(line string) (uint32, string, error) {
+	line, _, _ = strings.Cut(line, "#")
+	fields := strings.Fields(line)
+	if len(fields) == 0 {
+		return 0, "", nil
+	}
+	if len(fields) != 2 || !strings.HasPrefix(fields[1], "U+") {
+		return 0, "", fmt.Errorf("invalid line: %q", line)
+	}
+	code, err := strconv.ParseUint(strings.TrimPrefix(fields[0], "0x"), 16, 32)
+	if err != nil {
+		return 0, "", fmt.Errorf("invalid code: %q", fields[0])
+	}
+	var sb strings.Builder
+	for _, cp := range strings.Split(fields[1][2:], "+") {
+		r, err := strconv.ParseUint(cp, 16, 32)
+		if err != nil {
+			return 0, "", fmt.Errorf("invalid code point: %q", cp)
+		}
+		sb.WriteRune(rune(r))
+	}
+	return uint32(code), sb.String(), nil
+}
+
+func read_tables(name string) (tables, error) {
+	res := tables{
+		plane1: map[byte]*[94]rune{},
+		plane2: map[byte]*[94]rune{},
+		pairs:  map[uint16]string{},
+	}
+	fp, err := os.Open(name)
+	if err != nil {
+		return res, err
+	}
+	defer fp.Close()
+	scanner := bufio.NewScanner(fp)
+	for scanner.Scan() {
+		code, s, err := parse_line(scanner.Text())
+		if err != nil {
+			return res, err
+		}
+		plane, plane2 := res.plane1, code>>16 == 0x8f
+		switch {
+		case s == "" || code>>8 == 0x8e:
+			// half-width kana are decoded as in EUC-JP
+			continue
+		case plane2:
+			plane = res.plane2
+		case code > 0xffff:
+			return res, fmt.Errorf("invalid code: %X", code)
+		}
+		row, cell := byte(code>>8)-0xa0, byte(code)-0xa0
+		if row < 1 || row > 94 || cell < 1 || cell > 94 {
+			return res, fmt.Errorf("invalid code: %X", code)
+		}
+		if plane2 && !slices.Contains(plane2_rows, row) {
+			continue
+		}
+		if utf8.RuneCountInString(s) != 1 {
+			if plane2 {
+				return res, fmt.Errorf("pair in plane 2: %X", code)
+			}
+			res.pairs[uint16(row)<<8|uint16(cell)] = s
+			continue
+		}
+		if !plane2 && jisx0208(code) == s {
+			continue
+		}
+		if plane[row] == nil {
+			plane[row] = &[94]rune{}
+		}
+		plane[row][cell-1], _ = utf8.DecodeRuneInString(s)
+	}
+	return res, scanner.Err()
+}
+
+func write_plane(buf *bytes.Buffer, plane map[byte]*[94]rune) {
+	rows := []int{}
+	for row := range plane {
+		rows = append(rows, int(row))
+	}
+	slices.Sort(rows)
+	for _, row := range rows {
+		fmt.Fprintf(buf, "\t%d: {\n", row)
+		for idx, r := range plane[byte(row)] {
+			if idx%10 == 0 {
+				buf.WriteString("\t\t")
+			}
+			if r == 0 {
+				buf.WriteString("0")
+			} else {
+				fmt.Fprintf(buf, "0x%04x", r)
+			}
+			if idx%10 == 9 || idx == 93 {
+				buf.WriteString(",\n")
+			} else {
+				buf.WriteString(", ")
+			}
+		}
+		buf.WriteString("\t},\n")
+	}
+}
+
+func generate(t tables, source string) ([]byte, error) {
+	buf := &bytes.Buffer{}
+	fmt.Fprintf(buf, "// Code generated by gen-jisx0213 from %s; DO NOT EDIT.\n\n", source)
+	buf.WriteString("package uhd\n\n")
+	buf.WriteString("// jisx0213_plane1 holds the characters of JIS X 0213 plane 1 that are not in\n")
+	buf.WriteString("// JIS X 0208, by row and then cell. 0 is a cell of JIS X 0208 or an empty one.\n")
+	buf.WriteString("var jisx0213_plane1 = map[byte]*[94]rune{\n")
+	write_plane(buf, t.plane1)
+	buf.WriteString("}\n\n")
+	buf.WriteString("// jisx0213_plane2 holds JIS X 0213 plane 2 like jisx0213_plane1.\n")
+	buf.WriteString("var jisx0213_plane2 = map[byte]*[94]rune{\n")
+	write_plane(buf, t.plane2)
+	buf.WriteString("}\n\n")
+	buf.WriteString("// jisx0213_pairs holds the cells of plane 1 that are a base character and a\n")
+	buf.WriteString("// combining one, by row<<8 | cell.\n")
+	buf.WriteString("var jisx0213_pairs = map[uint16]string{\n")
+	keys := []int{}
+	for key := range t.pairs {
+		keys = append(keys, int(key))
+	}
+	slices.Sort(keys)
+	for _, key := range keys {
+		fmt.Fprintf(buf, "\t%#04x: \"", key)
+		for _, r := range t.pairs[uint16(key)] {
+			fmt.Fprintf(buf, "\\u%04x", r)
+		}
+		buf.WriteString("\",\n")
+	}
+	buf.WriteString("}\n")
+	return format.Source(buf.Bytes())
+}
+
+func main() {
+	if len(os.Args) != 3 {
+		log.Fatal("usage: gen-jisx0213 MAPPING OUTPUT")
+	}
+	t, err := read_tables(os.Args[1])
+	if err != nil {
+		log.Fatal(err)
+	}
+	src, err := generate(t, os.Args[1])
+	if err != nil {
+		log.Fatal(err)
+	}
+	if err := os.WriteFile(os.Args[2], src, 0o644); err != nil {
+		log.Fatal(err)
+	}
+}
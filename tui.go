@@ -0,0 +1,310 @@
+package main
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"io"
+	"log/slog"
+	"os"
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/wtnb75/uhd/pkg/uhd"
+	"golang.org/x/term"
+)
+
+var tui_layouts = []string{"jhd", "hexdump", "bytes"}
+
+var tui_encodings = []string{
+	"utf-8", "shift-jis", "cp932", "euc-jp", "iso-2022-jp", "euc-kr", "gb18030", "big5",
+	"utf-16le", "utf-16be", "utf-32le", "utf-32be",
+}
+
+type viewer struct {
+	input     io.ReaderAt
+	size      uint64
+	layouts   []string
+	layoutidx int
+	encodings []string
+	encidx    int
+	top       uint64
+	cursor    uint64
+	rows      int
+	message   string
+}
+
+func (v *viewer) layout() (*uhd.Layout, error) {
+	layout, err := new_layout(v.layouts[v.layoutidx], v.encodings[v.encidx])
+	if err != nil {
+		return nil, err
+	}
+	layout.SetAddressLimit(v.size)
+	return layout, nil
+}
+
+func (v *viewer) lastrow() uint64 {
+	if v.size == 0 {
+		return 0
+	}
+	return (v.size - 1) / uint64(option.Width)
+}
+
+// screen returns the lines of the current view, including the status line.
+func (v *viewer) screen() ([]string, error) {
+	layout, err := v.layout()
+	if err != nil {
+		return nil, err
+	}
+	body := max(1, v.rows-1)
+	rows, err := layout.RenderRows(v.input, v.size, v.top, body)
+	if err != nil {
+		return nil, err
+	}
+	width := uint64(option.Width)
+	res := make([]string, 0, v.rows)
+	for i := range body {
+		row := v.top + uint64(i)
+		var rendered uhd.Row
+		if i < len(rows) {
+			rendered = rows[i]
+		}
+		var line string
+		if v.cursor/width == row {
+			line = layout.ComposeRow(rendered, int(v.cursor%width))
+		} else {
+			line = layout.ComposeRow(rendered)
+		}
+		res = append(res, line)
+	}
+	res = append(res, v.status())
+	return res, nil
+}
+
+func (v *viewer) status() string {
+	if v.message != "" {
+		return "\x1b[7m" + v.message + "\x1b[27m"
+	}
+	value := ""
+	if v.cursor < v.size {
+		b := make([]byte, 1)
+		if _, err := v.input.ReadAt(b, int64(v.cursor)); err == nil {
+			value = fmt.Sprintf(" 0x%02X %d", b[0], b[0])
+		}
+	}
+	return fmt.Sprintf("\x1b[7m%08X/%08X%s  %s  %s  q:quit g/G:top/end ::jump e/E:encoding L:layout\x1b[27m",
+		v.cursor, v.size, value, v.layouts[v.layoutidx], v.encodings[v.encidx])
+}
+
+func (v *viewer) move(delta int64) {
+	cur := int64(v.cursor) + delta
+	if cur < 0 {
+		cur = 0
+	}
+	if v.size == 0 {
+		cur = 0
+	} else if uint64(cur) >= v.size {
+		cur = int64(v.size - 1)
+	}
+	v.jump(uint64(cur))
+}
+
+// jump moves the cursor to offset and scrolls it into the view.
+func (v *viewer) jump(offset uint64) {
+	if v.size == 0 {
+		offset = 0
+	} else if offset >= v.size {
+		offset = v.size - 1
+	}
+	v.cursor = offset
+	row := offset / uint64(option.Width)
+	body := uint64(max(1, v.rows-1))
+	if row < v.top {
+		v.top = row
+	} else if row >= v.top+body {
+		v.top = row - body + 1
+	}
+}
+
+// key handles one key press and returns false to quit.
+func (v *viewer) key(k string, prompt func(string) (string, bool)) bool {
+	width := int64(option.Width)
+	page := int64(max(1, v.rows-1)) * width
+	v.message = ""
+	switch k {
+	case "q", "\x03":
+		return false
+	case "\x1b[A", "k":
+		v.move(-width)
+	case "\x1b[B", "j":
+		v.move(width)
+	case "\x1b[D", "h":
+		v.move(-1)
+	case "\x1b[C", "l":
+		v.move(1)
+	case "\x1b[5~", "\x02", "b":
+		v.top = uint64(max(0, int64(v.top)-page/width))
+		v.move(-page)
+	case "\x1b[6~", "\x06", " ":
+		v.top = min(v.lastrow(), v.top+uint64(page/width))
+		v.move(page)
+	case "\x1b[H", "\x1b[1~", "g":
+		v.top = 0
+		v.jump(0)
+	case "\x1b[F", "\x1b[4~", "G":
+		if v.size != 0 {
+			v.jump(v.size - 1)
+		}
+	case ":":
+		if s, ok := prompt("offset: "); ok {
+			if offset, err := strconv.ParseUint(strings.TrimSpace(s), 0, 64); err == nil {
+				v.jump(offset)
+			} else {
+				v.message = fmt.Sprintf("invalid offset: %q", s)
+			}
+		}
+	case "e":
+		v.encidx = (v.encidx + 1) % len(v.encodings)
+	case "E":
+		if s, ok := prompt("encoding: "); ok {
+			s = strings.TrimSpace(s)
+			if !uhd.ValidEncoding(s) {
+				v.message = fmt.Sprintf("unknown encoding: %q", s)
+			} else if idx := slices.Index(v.encodings, s); idx != -1 {
+				v.encidx = idx
+			} else {
+				v.encodings = append(v.encodings, s)
+				v.encidx = len(v.encodings) - 1
+			}
+		}
+	case "L":
+		v.layoutidx = (v.layoutidx + 1) % len(v.layouts)
+	}
+	return true
+}
+
+func (v *viewer) draw(out io.Writer) error {
+	lines, err := v.screen()
+	if err != nil {
+		return err
+	}
+	buf := &bytes.Buffer{}
+	buf.WriteString("\x1b[H")
+	for idx, line := range lines {
+		buf.WriteString(line + "\x1b[K")
+		if idx != len(lines)-1 {
+			buf.WriteString("\r\n")
+		}
+	}
+	_, err = out.Write(buf.Bytes())
+	return err
+}
+
+// read_line reads a line in raw mode, echoing it on the status line.
+func read_line(in io.Reader, out io.Writer, rows int, msg string) (string, bool) {
+	line := []byte{}
+	buf := make([]byte, 16)
+	for {
+		fmt.Fprintf(out, "\x1b[%d;1H\x1b[7m%s%s\x1b[27m\x1b[K", rows, msg, line)
+		n, err := in.Read(buf)
+		if err != nil {
+			return "", false
+		}
+		for _, ch := range buf[:n] {
+			switch {
+			case ch == '\r' || ch == '\n':
+				return string(line), true
+			case ch == 0x1b || ch == 0x03:
+				return "", false
+			case ch == 0x7f || ch == 0x08:
+				if len(line) != 0 {
+					line = line[:len(line)-1]
+				}
+			case 0x20 <= ch && ch <= 0x7e:
+				line = append(line, ch)
+			}
+		}
+	}
+}
+
+func do_tui(filename string) error {
+	if filename == "-" {
+		return errors.New("tui needs a file, not stdin")
+	}
+	rd, err := os.Open(filename)
+	if err != nil {
+		slog.Error("open", "file", filename, "err", err)
+		return err
+	}
+	defer rd.Close()
+	st, err := rd.Stat()
+	if err != nil {
+		return err
+	}
+	fd := int(os.Stdin.Fd())
+	if !term.IsTerminal(fd) {
+		return errors.New("tui needs a terminal")
+	}
+	v := &viewer{
+		input:     rd,
+		size:      uint64(st.Size()),
+		layouts:   slices.Clone(tui_layouts),
+		encodings: slices.Clone(tui_encodings),
+	}
+	if idx := slices.Index(v.layouts, option.Layout); idx != -1 {
+		v.layoutidx = idx
+	} else {
+		v.layouts = append([]string{option.Layout}, v.layouts...)
+	}
+	encoding := strings.Split(option.Encoding, ",")[0]
+	if encoding == "auto" {
+		sample := make([]byte, uhd.DetectSampleSize)
+		n, _ := rd.ReadAt(sample, 0)
+		encoding, _ = uhd.DetectEncoding(sample[:n])
+	}
+	if idx := slices.Index(v.encodings, encoding); idx != -1 {
+		v.encidx = idx
+	} else {
+		v.encodings = append([]string{encoding}, v.encodings...)
+	}
+	if _, err := v.layout(); err != nil {
+		return err
+	}
+	skip, err := strconv.ParseUint(option.Skip, 0, 64)
+	if err != nil {
+		return err
+	}
+	state, err := term.MakeRaw(fd)
+	if err != nil {
+		return err
+	}
+	defer term.Restore(fd, state)
+	out := os.Stdout
+	fmt.Fprint(out, "\x1b[?1049h\x1b[?25l")
+	defer fmt.Fprint(out, "\x1b[?25h\x1b[?1049l")
+	prompt := func(msg string) (string, bool) {
+		return read_line(os.Stdin, out, v.rows, msg)
+	}
+	buf := make([]byte, 16)
+	for first := true; ; first = false {
+		if _, rows, err := term.GetSize(int(out.Fd())); err == nil {
+			v.rows = rows
+		} else {
+			v.rows = 24
+		}
+		if first {
+			v.jump(skip)
+		}
+		if err := v.draw(out); err != nil {
+			return err
+		}
+		n, err := os.Stdin.Read(buf)
+		if err != nil {
+			return err
+		}
+		if !v.key(string(buf[:n]), prompt) {
+			return nil
+		}
+	}
+}
@@ -0,0 +1,53 @@
+package main
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+)
+
+func TestViewer_Key(t *testing.T) {
+	setLayoutOption(t)
+	data := make([]byte, 1000)
+	v := &viewer{
+		input:     bytes.NewReader(data),
+		size:      uint64(len(data)),
+		layouts:   tui_layouts,
+		encodings: tui_encodings,
+		rows:      5,
+	}
+	noprompt := func(string) (string, bool) { return "", false }
+	jump := func(string) (string, bool) { return "0x200", true }
+	steps := []struct {
+		key    string
+		prompt func(string) (string, bool)
+		cursor uint64
+		top    uint64
+	}{
+		{"j", noprompt, 16, 0},
+		{"l", noprompt, 17, 0},
+		{" ", noprompt, 81, 4},
+		{":", jump, 0x200, 29},
+		{"k", noprompt, 0x1f0, 29},
+		{"G", noprompt, 999, 59},
+		{"g", noprompt, 0, 0},
+	}
+	for _, step := range steps {
+		if !v.key(step.key, step.prompt) {
+			t.Fatal("quit", "key", step.key)
+		}
+		if v.cursor != step.cursor || v.top != step.top {
+			t.Errorf("key %q: cursor=%d top=%d, want cursor=%d top=%d", step.key, v.cursor, v.top, step.cursor, step.top)
+		}
+	}
+	lines, err := v.screen()
+	if err != nil {
+		t.Fatal("screen", "err", err)
+	}
+	if len(lines) != 5 || !strings.HasPrefix(lines[0], "00000000") || !strings.Contains(lines[0], "\x1b[7m00\x1b[27m") {
+		t.Errorf("unexpected screen: %q", lines)
+	}
+	if v.key("q", noprompt) {
+		t.Error("q does not quit")
+	}
+}